@@ -0,0 +1,11 @@
+data "bunnycdn_hostname" "test" {
+  pullzone_id = 1
+  hostname = "test.ehealth.co.id"
+}
+
+check "certificate" {
+  assert {
+    condition = data.bunnycdn_hostname.test.has_certificate
+    error_message = "test.ehealth.co.id has no certificate"
+  }
+}
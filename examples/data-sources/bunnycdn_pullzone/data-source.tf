@@ -0,0 +1,7 @@
+data "bunnycdn_pullzone" "by_name" {
+  name = "test-ehealth-co-id"
+}
+
+data "bunnycdn_pullzone" "by_id" {
+  id = 1
+}
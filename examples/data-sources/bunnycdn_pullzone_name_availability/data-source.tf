@@ -0,0 +1,3 @@
+data "bunnycdn_pullzone_name_availability" "test" {
+  name = "test-ehealth-co-id"
+}
@@ -0,0 +1,8 @@
+data "bunnycdn_pullzones" "storage" {
+  name_regex = "^test-"
+  origin_type = 2
+}
+
+data "bunnycdn_pullzones" "by_hostname" {
+  hostname = "test.ehealth.co.id"
+}
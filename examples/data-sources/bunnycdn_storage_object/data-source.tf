@@ -0,0 +1,13 @@
+data "bunnycdn_storage_object" "config" {
+  storagezone_id = 1
+  path           = "config/app.json"
+}
+
+data "bunnycdn_storage_object" "config_directory" {
+  storagezone_id = 1
+  path           = "config/"
+}
+
+locals {
+  app_config = jsondecode(data.bunnycdn_storage_object.config.content)
+}
@@ -0,0 +1,9 @@
+data "bunnycdn_storagezone" "assets" {
+  name = "ehealth-assets"
+}
+
+resource "bunnycdn_pullzone" "assets" {
+  name            = "ehealth-assets"
+  origin_type     = 2
+  storage_zone_id = data.bunnycdn_storagezone.assets.id
+}
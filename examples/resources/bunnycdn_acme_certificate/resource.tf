@@ -0,0 +1,8 @@
+resource "bunnycdn_acme_certificate" "test" {
+  pullzone_id = resource.bunnycdn_pullzone.test.id
+  hostname = resource.bunnycdn_hostname.test.hostname
+  subject_alternative_names = ["*.test.ehealth.co.id"]
+  dns_zone_id = 12345
+  email = "admin@ehealth.co.id"
+  renew_before_days = 30
+}
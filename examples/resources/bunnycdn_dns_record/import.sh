@@ -0,0 +1,2 @@
+# <zone_id>/<record_id>
+terraform import bunnycdn_dns_record.mail 1/2
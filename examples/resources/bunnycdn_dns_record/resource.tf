@@ -0,0 +1,34 @@
+resource "bunnycdn_dns_record" "cdn" {
+  zone_id     = bunnycdn_dns_zone.test.id
+  name        = "cdn"
+  type        = "PullZone"
+  pullzone_id = bunnycdn_pullzone.test.id
+}
+
+resource "bunnycdn_dns_record" "mail" {
+  zone_id  = bunnycdn_dns_zone.test.id
+  type     = "MX"
+  value    = "mail.example.com"
+  priority = 10
+}
+
+resource "bunnycdn_dns_record" "caa" {
+  zone_id = bunnycdn_dns_zone.test.id
+  type    = "CAA"
+  value   = "letsencrypt.org"
+  tag     = "issue"
+  flags   = 0
+}
+
+resource "bunnycdn_dns_record" "origin" {
+  zone_id      = bunnycdn_dns_zone.test.id
+  name         = "origin"
+  type         = "A"
+  value        = "192.0.2.10"
+  ttl          = 60
+  monitor_type = "http"
+
+  smart_routing_type    = "geolocation"
+  geolocation_latitude  = 50.11
+  geolocation_longitude = 8.68
+}
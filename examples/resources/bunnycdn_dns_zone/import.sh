@@ -0,0 +1,5 @@
+# by DNS zone ID
+terraform import bunnycdn_dns_zone.test 1
+
+# by domain
+terraform import bunnycdn_dns_zone.test example.com
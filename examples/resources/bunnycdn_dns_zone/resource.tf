@@ -0,0 +1,11 @@
+resource "bunnycdn_dns_zone" "test" {
+  domain    = "example.com"
+  soa_email = "hostmaster@example.com"
+
+  logging_enabled        = true
+  log_anonymization_type = "drop"
+}
+
+output "registrar_nameservers" {
+  value = bunnycdn_dns_zone.test.nameservers
+}
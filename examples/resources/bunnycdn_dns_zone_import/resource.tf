@@ -0,0 +1,9 @@
+resource "bunnycdn_dns_zone_import" "test" {
+  zone_id     = bunnycdn_dns_zone.test.id
+  content     = file("${path.module}/example.com.zone")
+  default_ttl = 3600
+}
+
+output "skipped_records" {
+  value = bunnycdn_dns_zone_import.test.unsupported
+}
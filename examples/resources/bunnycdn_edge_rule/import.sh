@@ -0,0 +1 @@
+terraform import bunnycdn_edge_rule.blog_redirect 1/2b6f1e7a-6c1d-4b8e-9f0a-3c5d7e9f1a2b
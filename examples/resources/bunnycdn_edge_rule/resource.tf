@@ -0,0 +1,30 @@
+# redirect the legacy blog to the new domain with a 301
+resource "bunnycdn_edge_rule" "blog_redirect" {
+  pullzone_id        = resource.bunnycdn_pullzone.test.id
+  description        = "Redirect legacy blog"
+  action_type        = "redirect"
+  action_parameter_1 = "https://blog.ehealth.co.id{{path}}"
+  action_parameter_2 = "301"
+
+  triggers = [
+    {
+      type            = "url"
+      pattern_matches = ["*/blog/*"]
+    },
+  ]
+}
+
+resource "bunnycdn_edge_rule" "security_header" {
+  pullzone_id        = resource.bunnycdn_pullzone.test.id
+  description        = "HSTS"
+  action_type        = "set_response_header"
+  action_parameter_1 = "Strict-Transport-Security"
+  action_parameter_2 = "max-age=31536000"
+
+  triggers = [
+    {
+      type            = "url"
+      pattern_matches = ["*"]
+    },
+  ]
+}
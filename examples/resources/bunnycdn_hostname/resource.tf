@@ -3,6 +3,24 @@ resource "bunnycdn_hostname" "test" {
   hostname = "test.ehealth.co.id"
   enable_ssl = true
   force_ssl = false
-  certificate = "_somebase64urlencodedcertificate_"
-  certificate_key = "_somebase64urlencodedcertificatekey_"
-}
\ No newline at end of file
+  # PEM files, or base64 encoded DER files
+  certificate = file("path/to/certificate.crt")
+  certificate_key = file("path/to/private.key")
+}
+
+resource "bunnycdn_hostname" "pkcs12" {
+  pullzone_id = resource.bunnycdn_pullzone.test.id
+  hostname = "secure.ehealth.co.id"
+  enable_ssl = true
+  force_ssl = true
+  # PKCS#12 bundle containing the certificate and key
+  certificate = filebase64("path/to/certificate.p12")
+  certificate_password = var.certificate_password
+}
+
+resource "bunnycdn_hostname" "bunny_dns" {
+  pullzone_id = resource.bunnycdn_pullzone.test.id
+  hostname = "cdn.ehealth.co.id"
+  # creates a CNAME to the pull zone before the free certificate is loaded
+  dns_zone_id = resource.bunnycdn_dns_zone.test.id
+}
@@ -7,4 +7,5 @@ resource "bunnycdn_pullzone" "test" {
   # storage_zone_id = 999999
   enable_smart_cache = true
   disable_cookie = false
+  deletion_protection = true
 }
\ No newline at end of file
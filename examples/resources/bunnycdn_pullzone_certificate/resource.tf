@@ -0,0 +1,6 @@
+resource "bunnycdn_pullzone_certificate" "test" {
+  pullzone_id = resource.bunnycdn_pullzone.test.id
+  hostname = resource.bunnycdn_hostname.test.hostname
+  certificate = file("path/to/certificate.crt")
+  certificate_key = file("path/to/private.key")
+}
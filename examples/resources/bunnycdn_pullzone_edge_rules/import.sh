@@ -0,0 +1 @@
+terraform import bunnycdn_pullzone_edge_rules.test 1
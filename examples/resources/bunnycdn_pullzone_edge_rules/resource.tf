@@ -0,0 +1,34 @@
+resource "bunnycdn_pullzone_edge_rules" "test" {
+  pullzone_id = resource.bunnycdn_pullzone.test.id
+
+  rules = [
+    {
+      description = "Block admin from outside Indonesia"
+      action_type = "block_request"
+      triggers = [
+        {
+          type            = "url"
+          pattern_matches = ["*/admin/*"]
+        },
+        {
+          type                  = "country_code"
+          pattern_matches       = ["ID"]
+          pattern_matching_type = 2
+        },
+      ]
+      trigger_matching_type = 1
+    },
+    {
+      description        = "Redirect legacy blog"
+      action_type        = "redirect"
+      action_parameter_1 = "https://blog.ehealth.co.id{{path}}"
+      action_parameter_2 = "301"
+      triggers = [
+        {
+          type            = "url"
+          pattern_matches = ["*/blog/*"]
+        },
+      ]
+    },
+  ]
+}
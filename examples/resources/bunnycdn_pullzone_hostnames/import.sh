@@ -0,0 +1 @@
+terraform import bunnycdn_pullzone_hostnames.test 1
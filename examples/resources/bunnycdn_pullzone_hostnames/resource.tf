@@ -0,0 +1,14 @@
+resource "bunnycdn_pullzone_hostnames" "test" {
+  pullzone_id = resource.bunnycdn_pullzone.test.id
+  remove_unmanaged = true
+  hostnames = {
+    "test.ehealth.co.id" = {}
+    "static.ehealth.co.id" = {
+      force_ssl = false
+    }
+    "legacy.ehealth.co.id" = {
+      enable_ssl = false
+      force_ssl = false
+    }
+  }
+}
@@ -0,0 +1,14 @@
+resource "bunnycdn_redirect_map" "legacy" {
+  pullzone_id = resource.bunnycdn_pullzone.test.id
+  name        = "legacy-site"
+  hostname    = "www.ehealth.co.id"
+
+  redirects = {
+    "/about-us.html" = "https://www.ehealth.co.id/about"
+    "/contact.php"   = "https://www.ehealth.co.id/contact"
+  }
+
+  # source,target,status
+  # /old/page.html,https://www.ehealth.co.id/new/page,301
+  csv_file = "${path.module}/redirects.csv"
+}
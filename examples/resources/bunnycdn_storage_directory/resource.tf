@@ -0,0 +1,6 @@
+resource "bunnycdn_storage_directory" "site" {
+  storagezone_id = resource.bunnycdn_storagezone.test.id
+  source         = "${path.module}/public"
+  prefix         = "site"
+  concurrency    = 16
+}
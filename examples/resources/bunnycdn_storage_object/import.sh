@@ -0,0 +1 @@
+terraform import bunnycdn_storage_object.robots 1/robots.txt
@@ -0,0 +1,17 @@
+resource "bunnycdn_storage_object" "robots" {
+  storagezone_id = resource.bunnycdn_storagezone.test.id
+  path           = "robots.txt"
+  content        = "User-agent: *\nDisallow: /\n"
+}
+
+resource "bunnycdn_storage_object" "maintenance" {
+  storagezone_id = resource.bunnycdn_storagezone.test.id
+  path           = "maintenance/index.html"
+  source         = "${path.module}/maintenance.html"
+}
+
+resource "bunnycdn_storage_object" "favicon" {
+  storagezone_id = resource.bunnycdn_storagezone.test.id
+  path           = "favicon.ico"
+  content_base64 = filebase64("${path.module}/favicon.ico")
+}
@@ -0,0 +1 @@
+terraform import bunnycdn_storagezone.test 1
@@ -0,0 +1,8 @@
+resource "bunnycdn_storagezone" "test" {
+  name                = "ehealth-assets"
+  region              = "DE"
+  replication_regions = ["NY", "SG"]
+
+  custom_404_file_path = "/404.html"
+  rewrite_404_to_200   = false
+}
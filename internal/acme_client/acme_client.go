@@ -0,0 +1,226 @@
+package acme_client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/acme"
+)
+
+// Dns01Solver publishes and removes the TXT records of DNS-01 challenges.
+type Dns01Solver interface {
+	Present(ctx context.Context, fqdn string, value string) error
+	CleanUp(ctx context.Context, fqdn string, value string) error
+}
+
+type Request struct {
+	DirectoryUrl    string
+	Email           string
+	CaCertificate   string
+	AccountKeyPem   string
+	Domains         []string
+	PropagationWait time.Duration
+}
+
+type Certificate struct {
+	AccountKeyPem  string
+	CertificatePem string
+	PrivateKeyPem  string
+	NotAfter       time.Time
+}
+
+// ChallengeFqdn returns the name of the TXT record that validates domain.
+func ChallengeFqdn(domain string) string {
+	return "_acme-challenge." + strings.TrimPrefix(domain, "*.")
+}
+
+func Obtain(ctx context.Context, request Request, solver Dns01Solver) (*Certificate, error) {
+	accountKey, accountKeyPem, err := loadOrGenerateKey(request.AccountKeyPem)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading account key: %w", err)
+	}
+
+	httpClient, err := newHttpClient(request.CaCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &acme.Client{
+		Key:          accountKey,
+		HTTPClient:   httpClient,
+		DirectoryURL: request.DirectoryUrl,
+		UserAgent:    "terraform-provider-bunnycdn",
+	}
+
+	account := &acme.Account{}
+	if request.Email != "" {
+		account.Contact = []string{"mailto:" + request.Email}
+	}
+	_, err = client.Register(ctx, account, acme.AcceptTOS)
+	if err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, fmt.Errorf("failed registering account: %w", err)
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(request.Domains...))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating order: %w", err)
+	}
+
+	for _, authzUrl := range order.AuthzURLs {
+		err = authorize(ctx, client, authzUrl, request.PropagationWait, solver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, fmt.Errorf("failed waiting for order: %w", err)
+	}
+
+	certificateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: request.Domains[0]},
+		DNSNames: request.Domains,
+	}, certificateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed finalizing order: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var certificatePem []byte
+	for _, der := range chain {
+		certificatePem = append(certificatePem, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	privateKeyPem, err := encodeKey(certificateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Certificate{
+		AccountKeyPem:  accountKeyPem,
+		CertificatePem: string(certificatePem),
+		PrivateKeyPem:  privateKeyPem,
+		NotAfter:       leaf.NotAfter,
+	}, nil
+}
+
+func authorize(ctx context.Context, client *acme.Client, authzUrl string, propagationWait time.Duration, solver Dns01Solver) error {
+	authz, err := client.GetAuthorization(ctx, authzUrl)
+	if err != nil {
+		return fmt.Errorf("failed reading authorization: %w", err)
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, item := range authz.Challenges {
+		if item.Type == "dns-01" {
+			challenge = item
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("no dns-01 challenge offered for %s", authz.Identifier.Value)
+	}
+
+	value, err := client.DNS01ChallengeRecord(challenge.Token)
+	if err != nil {
+		return err
+	}
+
+	fqdn := ChallengeFqdn(authz.Identifier.Value)
+	err = solver.Present(ctx, fqdn, value)
+	if err != nil {
+		return fmt.Errorf("failed creating challenge record %s: %w", fqdn, err)
+	}
+	defer solver.CleanUp(ctx, fqdn, value) //nolint:errcheck
+
+	select {
+	case <-time.After(propagationWait):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	_, err = client.Accept(ctx, challenge)
+	if err != nil {
+		return fmt.Errorf("failed accepting challenge for %s: %w", authz.Identifier.Value, err)
+	}
+
+	_, err = client.WaitAuthorization(ctx, authz.URI)
+	if err != nil {
+		return fmt.Errorf("failed validating %s: %w", authz.Identifier.Value, err)
+	}
+	return nil
+}
+
+func newHttpClient(caCertificate string) (*http.Client, error) {
+	if caCertificate == "" {
+		return http.DefaultClient, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(caCertificate)) {
+		return nil, errors.New("directory_ca_certificate does not contain a PEM certificate")
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{RootCAs: pool},
+		},
+	}, nil
+}
+
+func loadOrGenerateKey(keyPem string) (crypto.Signer, string, error) {
+	if keyPem == "" {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, "", err
+		}
+		encoded, err := encodeKey(key)
+		return key, encoded, err
+	}
+
+	block, _ := pem.Decode([]byte(keyPem))
+	if block == nil {
+		return nil, "", errors.New("key is not PEM encoded")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, keyPem, nil
+}
+
+func encodeKey(key *ecdsa.PrivateKey) (string, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), nil
+}
@@ -1,7 +1,16 @@
 package bunnycdn_api
 
+import (
+	"strconv"
+	"sync"
+)
+
 type BunnycdnApi struct {
 	ApiKey string
+
+	// edgeRuleLocks holds a *sync.Mutex per pull zone ID, serializing the
+	// edge rule writes of resources applied in parallel
+	edgeRuleLocks sync.Map
 }
 
 func NewBunnycdnApi(apiKey string) *BunnycdnApi {
@@ -9,3 +18,24 @@ func NewBunnycdnApi(apiKey string) *BunnycdnApi {
 		ApiKey: apiKey,
 	}
 }
+
+// TypeCode returns the code of the type name in names. Codes the
+// provider has no name for yet can be given as a number.
+func TypeCode(names map[string]int64, name string) (int64, bool) {
+	if code, ok := names[name]; ok {
+		return code, true
+	}
+	code, err := strconv.ParseInt(name, 10, 64)
+	return code, err == nil && code >= 0
+}
+
+// typeName returns the name of code in names, or the code itself when
+// it has no name.
+func typeName(names map[string]int64, code int64) string {
+	for name, item := range names {
+		if item == code {
+			return name
+		}
+	}
+	return strconv.FormatInt(code, 10)
+}
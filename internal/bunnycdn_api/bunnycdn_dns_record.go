@@ -0,0 +1,261 @@
+package bunnycdn_api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	DnsRecordTypeTxt      int64 = 3
+	DnsRecordTypePullZone int64 = 7
+)
+
+// DnsRecordTypes maps the record type names used in the schema to the Type
+// codes of the API.
+var DnsRecordTypes = map[string]int64{
+	"A":        0,
+	"AAAA":     1,
+	"CNAME":    2,
+	"TXT":      DnsRecordTypeTxt,
+	"MX":       4,
+	"Redirect": 5,
+	"Flatten":  6,
+	"PullZone": DnsRecordTypePullZone,
+	"SRV":      8,
+	"CAA":      9,
+	"PTR":      10,
+	"Script":   11,
+	"NS":       12,
+}
+
+// DnsRecordSmartRoutingTypes maps the smart routing names used in the schema
+// to the SmartRoutingType codes of the API.
+var DnsRecordSmartRoutingTypes = map[string]int64{
+	"none":        0,
+	"latency":     1,
+	"geolocation": 2,
+}
+
+// DnsRecordMonitorTypes maps the monitor names used in the schema to the
+// MonitorType codes of the API.
+var DnsRecordMonitorTypes = map[string]int64{
+	"none": 0,
+	"ping": 1,
+	"http": 2,
+}
+
+// DnsRecordMonitorStatuses maps the MonitorStatus codes of the API to names.
+var DnsRecordMonitorStatuses = map[int64]string{
+	0: "unknown",
+	1: "online",
+	2: "offline",
+}
+
+// DnsRecord is a record of a DNS zone. Optional settings are pointers so they
+// are only sent when they are set.
+type DnsRecord struct {
+	Id                   int64    `json:"Id"`
+	Type                 int64    `json:"Type"`
+	Ttl                  int64    `json:"Ttl"`
+	Name                 string   `json:"Name"`
+	Value                string   `json:"Value"`
+	Weight               *int64   `json:"Weight,omitempty"`
+	Priority             *int64   `json:"Priority,omitempty"`
+	Port                 *int64   `json:"Port,omitempty"`
+	Flags                *int64   `json:"Flags,omitempty"`
+	Tag                  *string  `json:"Tag,omitempty"`
+	PullZoneId           *int64   `json:"PullZoneId,omitempty"`
+	ScriptId             *int64   `json:"ScriptId,omitempty"`
+	SmartRoutingType     *int64   `json:"SmartRoutingType,omitempty"`
+	LatencyZone          *string  `json:"LatencyZone,omitempty"`
+	GeolocationLatitude  *float64 `json:"GeolocationLatitude,omitempty"`
+	GeolocationLongitude *float64 `json:"GeolocationLongitude,omitempty"`
+	MonitorType          *int64   `json:"MonitorType,omitempty"`
+	MonitorStatus        int64    `json:"MonitorStatus"`
+	LinkName             string   `json:"LinkName"`
+}
+
+// dnsRecordTypeName returns the schema name of code, or the code itself when
+// it has no name.
+func dnsRecordTypeName(names map[string]int64, code *int64) string {
+	if code == nil {
+		return typeName(names, 0)
+	}
+	return typeName(names, *code)
+}
+
+// dnsRecordTypeCode returns the API code of a type name, or nil when the
+// value is not known yet.
+func dnsRecordTypeCode(names map[string]int64, value types.String) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	code, _ := TypeCode(names, value.ValueString())
+	return &code
+}
+
+func knownInt64Pointer(value types.Int64) *int64 {
+	if value.IsUnknown() {
+		return nil
+	}
+	return value.ValueInt64Pointer()
+}
+
+func knownStringPointer(value types.String) *string {
+	if value.IsUnknown() {
+		return nil
+	}
+	return value.ValueStringPointer()
+}
+
+func knownFloat64Pointer(value types.Float64) *float64 {
+	if value.IsUnknown() {
+		return nil
+	}
+	return value.ValueFloat64Pointer()
+}
+
+func DnsRecordToDnsRecordResourceModel(zoneId int64, resource *DnsRecord) model.DnsRecordResourceModel {
+	monitorStatus, ok := DnsRecordMonitorStatuses[resource.MonitorStatus]
+	if !ok {
+		monitorStatus = strconv.FormatInt(resource.MonitorStatus, 10)
+	}
+
+	return model.DnsRecordResourceModel{
+		Id:                   types.Int64Value(resource.Id),
+		ZoneId:               types.Int64Value(zoneId),
+		Name:                 types.StringValue(resource.Name),
+		Type:                 types.StringValue(typeName(DnsRecordTypes, resource.Type)),
+		Value:                types.StringValue(resource.Value),
+		Ttl:                  types.Int64Value(resource.Ttl),
+		Weight:               types.Int64PointerValue(resource.Weight),
+		Priority:             types.Int64PointerValue(resource.Priority),
+		Port:                 types.Int64PointerValue(resource.Port),
+		Flags:                types.Int64PointerValue(resource.Flags),
+		Tag:                  types.StringValue(stringOrEmpty(resource.Tag)),
+		PullzoneId:           types.Int64PointerValue(ifZeroThenNil(resource.PullZoneId)),
+		ScriptId:             types.Int64PointerValue(ifZeroThenNil(resource.ScriptId)),
+		SmartRoutingType:     types.StringValue(dnsRecordTypeName(DnsRecordSmartRoutingTypes, resource.SmartRoutingType)),
+		LatencyZone:          types.StringValue(stringOrEmpty(resource.LatencyZone)),
+		GeolocationLatitude:  types.Float64PointerValue(resource.GeolocationLatitude),
+		GeolocationLongitude: types.Float64PointerValue(resource.GeolocationLongitude),
+		MonitorType:          types.StringValue(dnsRecordTypeName(DnsRecordMonitorTypes, resource.MonitorType)),
+		MonitorStatus:        types.StringValue(monitorStatus),
+		LinkName:             types.StringValue(resource.LinkName),
+	}
+}
+
+func DnsRecordResourceModelToDnsRecord(resource model.DnsRecordResourceModel) DnsRecord {
+	recordType, _ := TypeCode(DnsRecordTypes, resource.Type.ValueString())
+
+	id := int64(0)
+	if !resource.Id.IsUnknown() {
+		id = resource.Id.ValueInt64()
+	}
+
+	return DnsRecord{
+		Id:                   id,
+		Type:                 recordType,
+		Ttl:                  resource.Ttl.ValueInt64(),
+		Name:                 resource.Name.ValueString(),
+		Value:                resource.Value.ValueString(),
+		Weight:               knownInt64Pointer(resource.Weight),
+		Priority:             knownInt64Pointer(resource.Priority),
+		Port:                 knownInt64Pointer(resource.Port),
+		Flags:                knownInt64Pointer(resource.Flags),
+		Tag:                  knownStringPointer(resource.Tag),
+		PullZoneId:           knownInt64Pointer(resource.PullzoneId),
+		ScriptId:             knownInt64Pointer(resource.ScriptId),
+		SmartRoutingType:     dnsRecordTypeCode(DnsRecordSmartRoutingTypes, resource.SmartRoutingType),
+		LatencyZone:          knownStringPointer(resource.LatencyZone),
+		GeolocationLatitude:  knownFloat64Pointer(resource.GeolocationLatitude),
+		GeolocationLongitude: knownFloat64Pointer(resource.GeolocationLongitude),
+		MonitorType:          dnsRecordTypeCode(DnsRecordMonitorTypes, resource.MonitorType),
+	}
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// DnsRecordGet returns the record with the given ID. bunny.net has no
+// endpoint for a single record, so it is looked up in the DNS zone.
+func (api *BunnycdnApi) DnsRecordGet(ctx context.Context, zoneId int64, id int64) (*DnsRecord, error) {
+	dnsZone, err := api.DnsZoneGet(ctx, zoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range dnsZone.Records {
+		if item.Id == id {
+			return &item, nil
+		}
+	}
+	return nil, model.NewDnsRecordError(404, zoneId, strconv.FormatInt(id, 10), "")
+}
+
+func (api *BunnycdnApi) DnsRecordCreate(ctx context.Context, zoneId int64, resource DnsRecord) (*DnsRecord, error) {
+	var createdResource DnsRecord
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("AccessKey", api.ApiKey).
+		SetBody(&resource).
+		SetResult(&createdResource).
+		Put(fmt.Sprintf("https://api.bunny.net/dnszone/%d/records", zoneId))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == 201 {
+		return &createdResource, nil
+	}
+
+	return nil, model.NewDnsRecordError(response.StatusCode(), zoneId, resource.Name, string(response.Body()))
+}
+
+func (api *BunnycdnApi) DnsRecordUpdate(ctx context.Context, zoneId int64, resource DnsRecord) error {
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("AccessKey", api.ApiKey).
+		SetBody(&resource).
+		Post(fmt.Sprintf("https://api.bunny.net/dnszone/%d/records/%d", zoneId, resource.Id))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() == 204 || response.StatusCode() == 200 {
+		return nil
+	}
+
+	return model.NewDnsRecordError(response.StatusCode(), zoneId, resource.Name, string(response.Body()))
+}
+
+func (api *BunnycdnApi) DnsRecordDelete(ctx context.Context, zoneId int64, resource DnsRecord) error {
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("AccessKey", api.ApiKey).
+		Delete(fmt.Sprintf("https://api.bunny.net/dnszone/%d/records/%d", zoneId, resource.Id))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() == 204 {
+		return nil
+	}
+
+	return model.NewDnsRecordError(response.StatusCode(), zoneId, resource.Name, string(response.Body()))
+}
@@ -0,0 +1,211 @@
+package bunnycdn_api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DnsZoneLogAnonymizationTypes maps the log anonymization names used in the
+// schema to the LogAnonymizationType codes of the API.
+var DnsZoneLogAnonymizationTypes = map[string]int64{
+	"one_digit": 0,
+	"drop":      1,
+}
+
+type DnsZone struct {
+	Id                            int64       `json:"Id"`
+	Domain                        string      `json:"Domain"`
+	Records                       []DnsRecord `json:"Records"`
+	CustomNameserversEnabled      bool        `json:"CustomNameserversEnabled"`
+	Nameserver1                   string      `json:"Nameserver1"`
+	Nameserver2                   string      `json:"Nameserver2"`
+	SoaEmail                      string      `json:"SoaEmail"`
+	NameserversDetected           bool        `json:"NameserversDetected"`
+	LoggingEnabled                bool        `json:"LoggingEnabled"`
+	LoggingIPAnonymizationEnabled bool        `json:"LoggingIPAnonymizationEnabled"`
+	LogAnonymizationType          int64       `json:"LogAnonymizationType"`
+}
+
+func DnsZoneToDnsZoneResourceModel(resource *DnsZone) model.DnsZoneResourceModel {
+	logAnonymizationType := strconv.FormatInt(resource.LogAnonymizationType, 10)
+	for name, code := range DnsZoneLogAnonymizationTypes {
+		if code == resource.LogAnonymizationType {
+			logAnonymizationType = name
+		}
+	}
+
+	return model.DnsZoneResourceModel{
+		Id:                            types.Int64Value(resource.Id),
+		Domain:                        types.StringValue(resource.Domain),
+		CustomNameserversEnabled:      types.BoolValue(resource.CustomNameserversEnabled),
+		Nameserver1:                   types.StringValue(resource.Nameserver1),
+		Nameserver2:                   types.StringValue(resource.Nameserver2),
+		SoaEmail:                      types.StringValue(resource.SoaEmail),
+		LoggingEnabled:                types.BoolValue(resource.LoggingEnabled),
+		LoggingIpAnonymizationEnabled: types.BoolValue(resource.LoggingIPAnonymizationEnabled),
+		LogAnonymizationType:          types.StringValue(logAnonymizationType),
+		Nameservers: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue(resource.Nameserver1),
+			types.StringValue(resource.Nameserver2),
+		}),
+		NameserversDetected: types.BoolValue(resource.NameserversDetected),
+	}
+}
+
+func DnsZoneResourceModelToDnsZone(resource model.DnsZoneResourceModel) DnsZone {
+	return DnsZone{
+		Id:                            resource.Id.ValueInt64(),
+		Domain:                        resource.Domain.ValueString(),
+		CustomNameserversEnabled:      resource.CustomNameserversEnabled.ValueBool(),
+		Nameserver1:                   resource.Nameserver1.ValueString(),
+		Nameserver2:                   resource.Nameserver2.ValueString(),
+		SoaEmail:                      resource.SoaEmail.ValueString(),
+		LoggingEnabled:                resource.LoggingEnabled.ValueBool(),
+		LoggingIPAnonymizationEnabled: resource.LoggingIpAnonymizationEnabled.ValueBool(),
+		LogAnonymizationType:          DnsZoneLogAnonymizationTypes[resource.LogAnonymizationType.ValueString()],
+	}
+}
+
+func (api *BunnycdnApi) DnsZoneGet(ctx context.Context, id int64) (*DnsZone, error) {
+	var resource DnsZone
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("AccessKey", api.ApiKey).
+		SetResult(&resource).
+		Get(fmt.Sprintf("https://api.bunny.net/dnszone/%d", id))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == 200 {
+		return &resource, nil
+	}
+
+	return nil, model.NewDnsZoneError(response.StatusCode(), id)
+}
+
+type DnsZoneList struct {
+	Items        []DnsZone `json:"Items"`
+	CurrentPage  int64     `json:"CurrentPage"`
+	TotalItems   int64     `json:"TotalItems"`
+	HasMoreItems bool      `json:"HasMoreItems"`
+}
+
+// DnsZoneList returns the DNS zones whose domain contains search, or all DNS
+// zones when search is empty. All pages are fetched.
+func (api *BunnycdnApi) DnsZoneList(ctx context.Context, search string) ([]DnsZone, error) {
+	var dnsZones []DnsZone
+
+	for page := 1; ; page++ {
+		var resource DnsZoneList
+
+		response, err := resty.New().R().
+			SetContext(ctx).
+			SetHeader("AccessKey", api.ApiKey).
+			SetQueryParams(map[string]string{
+				"page":    strconv.Itoa(page),
+				"perPage": "1000",
+				"search":  search,
+			}).
+			SetResult(&resource).
+			Get("https://api.bunny.net/dnszone")
+
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode() != 200 {
+			return nil, model.NewDnsZoneError(response.StatusCode(), 0)
+		}
+
+		dnsZones = append(dnsZones, resource.Items...)
+		if !resource.HasMoreItems || len(resource.Items) == 0 {
+			return dnsZones, nil
+		}
+	}
+}
+
+func (api *BunnycdnApi) DnsZoneCreate(ctx context.Context, resource DnsZone) (*DnsZone, error) {
+	var createdResource DnsZone
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("AccessKey", api.ApiKey).
+		SetBody(map[string]interface{}{
+			"Domain": resource.Domain,
+		}).
+		SetResult(&createdResource).
+		Post("https://api.bunny.net/dnszone")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == 201 || response.StatusCode() == 200 {
+		return &createdResource, nil
+	}
+
+	return nil, model.NewDnsZoneError(response.StatusCode(), resource.Id)
+}
+
+func (api *BunnycdnApi) DnsZoneUpdate(ctx context.Context, resource DnsZone) (*DnsZone, error) {
+	var updatedResource DnsZone
+
+	body := map[string]interface{}{
+		"CustomNameserversEnabled":      resource.CustomNameserversEnabled,
+		"LoggingEnabled":                resource.LoggingEnabled,
+		"LoggingIPAnonymizationEnabled": resource.LoggingIPAnonymizationEnabled,
+		"LogAnonymizationType":          resource.LogAnonymizationType,
+	}
+	if resource.CustomNameserversEnabled {
+		body["Nameserver1"] = resource.Nameserver1
+		body["Nameserver2"] = resource.Nameserver2
+	}
+	if resource.SoaEmail != "" {
+		body["SoaEmail"] = resource.SoaEmail
+	}
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("AccessKey", api.ApiKey).
+		SetBody(body).
+		SetResult(&updatedResource).
+		Post(fmt.Sprintf("https://api.bunny.net/dnszone/%d", resource.Id))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == 200 {
+		return &updatedResource, nil
+	}
+
+	return nil, model.NewDnsZoneError(response.StatusCode(), resource.Id)
+}
+
+func (api *BunnycdnApi) DnsZoneDelete(ctx context.Context, resource DnsZone) error {
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("AccessKey", api.ApiKey).
+		Delete(fmt.Sprintf("https://api.bunny.net/dnszone/%d", resource.Id))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() == 204 {
+		return nil
+	}
+
+	return model.NewDnsZoneError(response.StatusCode(), resource.Id)
+}
@@ -0,0 +1,294 @@
+package bunnycdn_api
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EdgeRuleMaxTriggers is the number of triggers bunny.net accepts per edge
+// rule.
+const EdgeRuleMaxTriggers = 5
+
+// EdgeRuleMaxPatternMatches is the number of patterns bunny.net accepts per
+// edge rule trigger.
+const EdgeRuleMaxPatternMatches = 20
+
+// EdgeRuleActionTypes maps the action type names used in the schema to the
+// ActionType codes of the API.
+var EdgeRuleActionTypes = map[string]int64{
+	"force_ssl":                     0,
+	"redirect":                      1,
+	"origin_url":                    2,
+	"override_cache_time":           3,
+	"block_request":                 4,
+	"set_response_header":           5,
+	"set_request_header":            6,
+	"force_download":                7,
+	"disable_token_authentication":  8,
+	"enable_token_authentication":   9,
+	"override_cache_time_public":    10,
+	"ignore_query_string":           11,
+	"disable_optimizer":             12,
+	"force_compression":             13,
+	"set_status_code":               14,
+	"bypass_perma_cache":            15,
+	"override_browser_cache_time":   16,
+	"origin_storage":                17,
+	"set_network_rate_limit":        18,
+	"set_connection_limit":          19,
+	"set_requests_per_second_limit": 20,
+}
+
+// EdgeRuleTriggerTypes maps the trigger type names used in the schema to the
+// trigger Type codes of the API.
+var EdgeRuleTriggerTypes = map[string]int64{
+	"url":                        0,
+	"request_header":             1,
+	"response_header":            2,
+	"url_extension":              3,
+	"country_code":               4,
+	"remote_ip":                  5,
+	"url_query_string":           6,
+	"random_chance":              7,
+	"status_code":                8,
+	"request_method":             9,
+	"cookie_value":               10,
+	"country_state_code":         11,
+	"origin_retry_attempt_count": 12,
+	"origin_connection_error":    13,
+}
+
+type EdgeRuleTrigger struct {
+	Type                int64    `json:"Type"`
+	PatternMatches      []string `json:"PatternMatches"`
+	PatternMatchingType int64    `json:"PatternMatchingType"`
+	Parameter1          *string  `json:"Parameter1"`
+}
+
+type EdgeRule struct {
+	Guid                string            `json:"Guid,omitempty"`
+	ActionType          int64             `json:"ActionType"`
+	ActionParameter1    *string           `json:"ActionParameter1"`
+	ActionParameter2    *string           `json:"ActionParameter2"`
+	Triggers            []EdgeRuleTrigger `json:"Triggers"`
+	TriggerMatchingType int64             `json:"TriggerMatchingType"`
+	Description         *string           `json:"Description"`
+	Enabled             bool              `json:"Enabled"`
+}
+
+func EdgeRuleToEdgeRuleResourceModel(pullzoneId int64, resource *EdgeRule) model.EdgeRuleResourceModel {
+	triggers := []model.EdgeRuleTriggerModel{}
+	for _, trigger := range resource.Triggers {
+		patternMatches := []types.String{}
+		for _, pattern := range trigger.PatternMatches {
+			patternMatches = append(patternMatches, types.StringValue(pattern))
+		}
+		triggers = append(triggers, model.EdgeRuleTriggerModel{
+			Type:                types.StringValue(typeName(EdgeRuleTriggerTypes, trigger.Type)),
+			PatternMatches:      patternMatches,
+			PatternMatchingType: types.Int64Value(trigger.PatternMatchingType),
+			Parameter1:          types.StringPointerValue(ifEmptyThenNil(trigger.Parameter1)),
+		})
+	}
+
+	return model.EdgeRuleResourceModel{
+		Id:                  types.StringValue(resource.Guid),
+		PullzoneId:          types.Int64Value(pullzoneId),
+		ActionType:          types.StringValue(typeName(EdgeRuleActionTypes, resource.ActionType)),
+		ActionParameter1:    types.StringPointerValue(ifEmptyThenNil(resource.ActionParameter1)),
+		ActionParameter2:    types.StringPointerValue(ifEmptyThenNil(resource.ActionParameter2)),
+		Triggers:            triggers,
+		TriggerMatchingType: types.Int64Value(resource.TriggerMatchingType),
+		Enabled:             types.BoolValue(resource.Enabled),
+		Description:         types.StringPointerValue(ifEmptyThenNil(resource.Description)),
+	}
+}
+
+func EdgeRuleResourceModelToEdgeRule(resource model.EdgeRuleResourceModel) EdgeRule {
+	triggers := []EdgeRuleTrigger{}
+	for _, trigger := range resource.Triggers {
+		patternMatches := []string{}
+		for _, pattern := range trigger.PatternMatches {
+			patternMatches = append(patternMatches, pattern.ValueString())
+		}
+		triggerType, _ := TypeCode(EdgeRuleTriggerTypes, trigger.Type.ValueString())
+		triggers = append(triggers, EdgeRuleTrigger{
+			Type:                triggerType,
+			PatternMatches:      patternMatches,
+			PatternMatchingType: trigger.PatternMatchingType.ValueInt64(),
+			Parameter1:          trigger.Parameter1.ValueStringPointer(),
+		})
+	}
+
+	actionType, _ := TypeCode(EdgeRuleActionTypes, resource.ActionType.ValueString())
+
+	guid := ""
+	if !resource.Id.IsUnknown() {
+		guid = resource.Id.ValueString()
+	}
+
+	return EdgeRule{
+		Guid:                guid,
+		ActionType:          actionType,
+		ActionParameter1:    resource.ActionParameter1.ValueStringPointer(),
+		ActionParameter2:    resource.ActionParameter2.ValueStringPointer(),
+		Triggers:            triggers,
+		TriggerMatchingType: resource.TriggerMatchingType.ValueInt64(),
+		Description:         resource.Description.ValueStringPointer(),
+		Enabled:             resource.Enabled.ValueBool(),
+	}
+}
+
+// EdgeRuleList returns the edge rules of the pull zone in execution order.
+func (api *BunnycdnApi) EdgeRuleList(ctx context.Context, pullzoneId int64) ([]EdgeRule, error) {
+	pullzone, err := api.PullzoneGet(ctx, pullzoneId)
+	if err != nil {
+		return nil, err
+	}
+	return pullzone.EdgeRules, nil
+}
+
+func (api *BunnycdnApi) EdgeRuleGet(ctx context.Context, pullzoneId int64, guid string) (*EdgeRule, error) {
+	edgeRules, err := api.EdgeRuleList(ctx, pullzoneId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range edgeRules {
+		if item.Guid == guid {
+			return &item, nil
+		}
+	}
+	return nil, model.NewEdgeRuleError(404, pullzoneId, guid, "")
+}
+
+// EdgeRuleNormalize returns resource without GUID and with empty parameters
+// set to nil, as read back from the API, so edge rules can be compared.
+func EdgeRuleNormalize(resource EdgeRule) EdgeRule {
+	resource.Guid = ""
+	return EdgeRuleResourceModelToEdgeRule(EdgeRuleToEdgeRuleResourceModel(0, &resource))
+}
+
+// edgeRuleLock returns the lock of the edge rules of the pull zone.
+func (api *BunnycdnApi) edgeRuleLock(pullzoneId int64) *sync.Mutex {
+	lock, _ := api.edgeRuleLocks.LoadOrStore(pullzoneId, &sync.Mutex{})
+	return lock.(*sync.Mutex)
+}
+
+// EdgeRuleAddOrUpdate creates the edge rule when it has no GUID and updates
+// it otherwise. bunny.net does not return the GUID of a created edge rule, so
+// it is the new edge rule of the pull zone with the same content, or else the
+// same description. Writes are serialized per pull zone, so edge rules created
+// in parallel are not mixed up.
+func (api *BunnycdnApi) EdgeRuleAddOrUpdate(ctx context.Context, pullzoneId int64, resource EdgeRule) (*EdgeRule, error) {
+	lock := api.edgeRuleLock(pullzoneId)
+	lock.Lock()
+	defer lock.Unlock()
+
+	existing := map[string]bool{}
+	if resource.Guid == "" {
+		edgeRules, err := api.EdgeRuleList(ctx, pullzoneId)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range edgeRules {
+			existing[item.Guid] = true
+		}
+	}
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("AccessKey", api.ApiKey).
+		SetBody(&resource).
+		Post(fmt.Sprintf("https://api.bunny.net/pullzone/%d/edgerules/addOrUpdate", pullzoneId))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() != 200 && response.StatusCode() != 201 && response.StatusCode() != 204 {
+		return nil, model.NewEdgeRuleError(response.StatusCode(), pullzoneId, resource.Guid, string(response.Body()))
+	}
+
+	if resource.Guid != "" {
+		return api.EdgeRuleGet(ctx, pullzoneId, resource.Guid)
+	}
+
+	edgeRules, err := api.EdgeRuleList(ctx, pullzoneId)
+	if err != nil {
+		return nil, err
+	}
+	created := []EdgeRule{}
+	for _, item := range edgeRules {
+		if !existing[item.Guid] {
+			created = append(created, item)
+		}
+	}
+	for _, item := range created {
+		if reflect.DeepEqual(EdgeRuleNormalize(item), EdgeRuleNormalize(resource)) {
+			return &item, nil
+		}
+	}
+	for _, item := range created {
+		if resource.Description != nil && *resource.Description != "" && item.Description != nil && *item.Description == *resource.Description {
+			return &item, nil
+		}
+	}
+	return nil, fmt.Errorf("the created edge rule was not found on pull zone %d", pullzoneId)
+}
+
+func (api *BunnycdnApi) EdgeRuleDelete(ctx context.Context, pullzoneId int64, guid string) error {
+	lock := api.edgeRuleLock(pullzoneId)
+	lock.Lock()
+	defer lock.Unlock()
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("AccessKey", api.ApiKey).
+		Delete(fmt.Sprintf("https://api.bunny.net/pullzone/%d/edgerules/%s", pullzoneId, guid))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() == 204 || response.StatusCode() == 200 {
+		return nil
+	}
+
+	return model.NewEdgeRuleError(response.StatusCode(), pullzoneId, guid, string(response.Body()))
+}
+
+func EdgeRuleToPullzoneEdgeRulesRuleModel(resource *EdgeRule) model.PullzoneEdgeRulesRuleModel {
+	edgeRule := EdgeRuleToEdgeRuleResourceModel(0, resource)
+	return model.PullzoneEdgeRulesRuleModel{
+		Id:                  edgeRule.Id,
+		ActionType:          edgeRule.ActionType,
+		ActionParameter1:    edgeRule.ActionParameter1,
+		ActionParameter2:    edgeRule.ActionParameter2,
+		Triggers:            edgeRule.Triggers,
+		TriggerMatchingType: edgeRule.TriggerMatchingType,
+		Enabled:             edgeRule.Enabled,
+		Description:         edgeRule.Description,
+	}
+}
+
+func PullzoneEdgeRulesRuleModelToEdgeRuleResourceModel(pullzoneId int64, resource model.PullzoneEdgeRulesRuleModel) model.EdgeRuleResourceModel {
+	return model.EdgeRuleResourceModel{
+		Id:                  resource.Id,
+		PullzoneId:          types.Int64Value(pullzoneId),
+		ActionType:          resource.ActionType,
+		ActionParameter1:    resource.ActionParameter1,
+		ActionParameter2:    resource.ActionParameter2,
+		Triggers:            resource.Triggers,
+		TriggerMatchingType: resource.TriggerMatchingType,
+		Enabled:             resource.Enabled,
+		Description:         resource.Description,
+	}
+}
@@ -42,6 +42,26 @@ func HostnameResourceModelToHostname(resource model.HostnameResourceModel) Hostn
 	}
 }
 
+func HostnameToHostnameDataSourceModel(pullzoneId int64, resource *Hostname) model.HostnameDataSourceModel {
+	return model.HostnameDataSourceModel{
+		PullzoneId:     types.Int64Value(pullzoneId),
+		Id:             types.Int64Value(resource.Id),
+		Hostname:       types.StringValue(resource.Hostname),
+		HasCertificate: types.BoolValue(resource.EnableSsl),
+		ForceSsl:       types.BoolValue(resource.ForceSsl),
+	}
+}
+
+func PullzoneCertificateResourceModelToHostname(resource model.PullzoneCertificateResourceModel) Hostname {
+	return Hostname{
+		Id:             resource.Id.ValueInt64(),
+		Hostname:       resource.Hostname.ValueString(),
+		EnableSsl:      true,
+		Certificate:    resource.Certificate.ValueStringPointer(),
+		CertificateKey: resource.CertificateKey.ValueStringPointer(),
+	}
+}
+
 func (api *BunnycdnApi) HostnameGet(ctx context.Context, pullzoneId int64, hostname string) (*Hostname, error) {
 	tflog.Info(ctx, "hostname get")
 
@@ -3,17 +3,20 @@ package bunnycdn_api
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"terraform-provider-bunnycdn/internal/model"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type PullzoneHostname struct {
-	Id             int64  `json:"Id"`
-	Value          string `json:"Value"`
-	HasCertificate bool   `json:"HasCertificate"`
-	ForceSsl       bool   `json:"ForceSSL"`
+	Id               int64  `json:"Id"`
+	Value            string `json:"Value"`
+	HasCertificate   bool   `json:"HasCertificate"`
+	ForceSsl         bool   `json:"ForceSSL"`
+	IsSystemHostname bool   `json:"IsSystemHostname"`
 }
 
 type Pullzone struct {
@@ -30,6 +33,7 @@ type Pullzone struct {
 	Hostnames                 []PullzoneHostname `json:"Hostnames"`
 	ErrorPageEnableCustomCode bool               `json:"ErrorPageEnableCustomCode"`
 	ErrorPageCustomCode       *string            `json:"ErrorPageCustomCode"`
+	EdgeRules                 []EdgeRule         `json:"EdgeRules,omitempty"`
 }
 
 func ifEmptyThenNil(value *string) *string {
@@ -46,6 +50,38 @@ func ifZeroThenNil(value *int64) *int64 {
 	return value
 }
 
+func PullzoneHostnameToPullzoneHostnamesHostnameModel(resource PullzoneHostname) model.PullzoneHostnamesHostnameModel {
+	return model.PullzoneHostnamesHostnameModel{
+		Id:        types.Int64Value(resource.Id),
+		EnableSsl: types.BoolValue(resource.HasCertificate),
+		ForceSsl:  types.BoolValue(resource.ForceSsl),
+	}
+}
+
+func pullzoneHostnamesToList(hostnames []PullzoneHostname) types.List {
+	elements := []attr.Value{}
+	for _, item := range hostnames {
+		elements = append(elements, types.ObjectValueMust(model.PullzoneHostnameModelType.AttrTypes, map[string]attr.Value{
+			"id":                 types.Int64Value(item.Id),
+			"value":              types.StringValue(item.Value),
+			"has_certificate":    types.BoolValue(item.HasCertificate),
+			"force_ssl":          types.BoolValue(item.ForceSsl),
+			"is_system_hostname": types.BoolValue(item.IsSystemHostname),
+		}))
+	}
+	return types.ListValueMust(model.PullzoneHostnameModelType, elements)
+}
+
+// PullzoneCdnDomain returns the system b-cdn.net hostname of the pull zone.
+func PullzoneCdnDomain(resource *Pullzone) string {
+	for _, item := range resource.Hostnames {
+		if item.IsSystemHostname {
+			return item.Value
+		}
+	}
+	return resource.Name + ".b-cdn.net"
+}
+
 func PullzoneToPullzoneResourceModel(resource *Pullzone) model.PullzoneResourceModel {
 
 	return model.PullzoneResourceModel{
@@ -59,6 +95,25 @@ func PullzoneToPullzoneResourceModel(resource *Pullzone) model.PullzoneResourceM
 		OriginHostHeader:          types.StringPointerValue(ifEmptyThenNil(resource.OriginHostHeader)),
 		ErrorPageEnableCustomCode: types.BoolValue(resource.ErrorPageEnableCustomCode),
 		ErrorPageCustomCode:       types.StringPointerValue(ifEmptyThenNil(resource.ErrorPageCustomCode)),
+		Hostnames:                 pullzoneHostnamesToList(resource.Hostnames),
+		CdnDomain:                 types.StringValue(PullzoneCdnDomain(resource)),
+	}
+}
+
+func PullzoneToPullzoneDataSourceModel(resource *Pullzone) model.PullzoneDataSourceModel {
+	return model.PullzoneDataSourceModel{
+		Id:                        types.Int64Value(resource.Id),
+		Name:                      types.StringValue(resource.Name),
+		OriginType:                types.Int64Value(resource.OriginType),
+		StorageZoneId:             types.Int64PointerValue(ifZeroThenNil(resource.StorageZoneId)),
+		OriginUrl:                 types.StringPointerValue(ifEmptyThenNil(resource.OriginUrl)),
+		EnableSmartCache:          types.BoolValue(resource.EnableSmartCache),
+		DisableCookies:            types.BoolValue(resource.DisableCookies),
+		OriginHostHeader:          types.StringPointerValue(ifEmptyThenNil(resource.OriginHostHeader)),
+		ErrorPageEnableCustomCode: types.BoolValue(resource.ErrorPageEnableCustomCode),
+		ErrorPageCustomCode:       types.StringPointerValue(ifEmptyThenNil(resource.ErrorPageCustomCode)),
+		Hostnames:                 pullzoneHostnamesToList(resource.Hostnames),
+		CdnDomain:                 types.StringValue(PullzoneCdnDomain(resource)),
 	}
 }
 
@@ -159,3 +214,72 @@ func (api *BunnycdnApi) PullzoneDelete(ctx context.Context, resource Pullzone) e
 
 	return model.NewPullzoneError(response.StatusCode(), resource.Id)
 }
+
+type PullzoneList struct {
+	Items        []Pullzone `json:"Items"`
+	CurrentPage  int64      `json:"CurrentPage"`
+	TotalItems   int64      `json:"TotalItems"`
+	HasMoreItems bool       `json:"HasMoreItems"`
+}
+
+// PullzoneList returns the pull zones whose name contains search, or all pull
+// zones when search is empty. All pages are fetched.
+func (api *BunnycdnApi) PullzoneList(ctx context.Context, search string) ([]Pullzone, error) {
+	var pullzones []Pullzone
+
+	for page := 1; ; page++ {
+		var resource PullzoneList
+
+		response, err := resty.New().R().
+			SetContext(ctx).
+			SetHeader("AccessKey", api.ApiKey).
+			SetQueryParams(map[string]string{
+				"page":    strconv.Itoa(page),
+				"perPage": "1000",
+				"search":  search,
+			}).
+			SetResult(&resource).
+			Get("https://api.bunny.net/pullzone")
+
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode() != 200 {
+			return nil, model.NewPullzoneError(response.StatusCode(), 0)
+		}
+
+		pullzones = append(pullzones, resource.Items...)
+		if !resource.HasMoreItems || len(resource.Items) == 0 {
+			return pullzones, nil
+		}
+	}
+}
+
+// PullzoneCheckAvailability reports whether name is still free to be used as
+// a pull zone name on b-cdn.net.
+func (api *BunnycdnApi) PullzoneCheckAvailability(ctx context.Context, name string) (bool, error) {
+	var resource struct {
+		Available bool `json:"Available"`
+	}
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("AccessKey", api.ApiKey).
+		SetBody(map[string]interface{}{
+			"Name": name,
+		}).
+		SetResult(&resource).
+		Post("https://api.bunny.net/pullzone/checkavailability")
+
+	if err != nil {
+		return false, err
+	}
+
+	if response.StatusCode() == 200 {
+		return resource.Available, nil
+	}
+
+	return false, model.NewPullzoneError(response.StatusCode(), 0)
+}
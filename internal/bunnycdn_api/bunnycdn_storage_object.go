@@ -0,0 +1,162 @@
+package bunnycdn_api
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"strings"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageObject is a file or directory of a storage zone as returned by the
+// directory listing of the storage API.
+type StorageObject struct {
+	Guid        string `json:"Guid"`
+	Path        string `json:"Path"`
+	ObjectName  string `json:"ObjectName"`
+	Length      int64  `json:"Length"`
+	LastChanged string `json:"LastChanged"`
+	IsDirectory bool   `json:"IsDirectory"`
+	Checksum    string `json:"Checksum"`
+	ContentType string `json:"ContentType"`
+}
+
+// StorageObjectChecksum returns the checksum of content in the format of the
+// storage API, an uppercase hex encoded SHA256 hash.
+func StorageObjectChecksum(content []byte) string {
+	return fmt.Sprintf("%X", sha256.Sum256(content))
+}
+
+// storageObjectUrl returns the storage API URL of objectPath in storagezone.
+// Directories are addressed with a trailing slash.
+func storageObjectUrl(storagezone *Storagezone, objectPath string) string {
+	segments := strings.Split(strings.TrimPrefix(objectPath, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return fmt.Sprintf("https://%s/%s/%s", storagezone.StorageHostname, url.PathEscape(storagezone.Name), strings.Join(segments, "/"))
+}
+
+func (api *BunnycdnApi) StorageObjectUpload(ctx context.Context, storagezone *Storagezone, objectPath string, content []byte) error {
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/octet-stream").
+		SetHeader("AccessKey", storagezone.Password).
+		SetHeader("Checksum", StorageObjectChecksum(content)).
+		SetBody(content).
+		Put(storageObjectUrl(storagezone, objectPath))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() == 201 {
+		return nil
+	}
+
+	return model.NewStorageObjectError(response.StatusCode(), objectPath, string(response.Body()))
+}
+
+func (api *BunnycdnApi) StorageObjectDownload(ctx context.Context, storagezone *Storagezone, objectPath string) ([]byte, error) {
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("AccessKey", storagezone.Password).
+		Get(storageObjectUrl(storagezone, objectPath))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == 200 {
+		return response.Body(), nil
+	}
+
+	return nil, model.NewStorageObjectError(response.StatusCode(), objectPath, string(response.Body()))
+}
+
+// StorageObjectList returns the objects in the directory directoryPath of
+// storagezone. Subdirectories are included but not listed recursively.
+func (api *BunnycdnApi) StorageObjectList(ctx context.Context, storagezone *Storagezone, directoryPath string) ([]StorageObject, error) {
+	var objects []StorageObject
+
+	directoryPath = strings.Trim(directoryPath, "/")
+	if directoryPath != "" {
+		directoryPath += "/"
+	}
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetHeader("AccessKey", storagezone.Password).
+		SetResult(&objects).
+		Get(storageObjectUrl(storagezone, directoryPath))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == 200 {
+		return objects, nil
+	}
+
+	return nil, model.NewStorageObjectError(response.StatusCode(), directoryPath, string(response.Body()))
+}
+
+// StorageObjectGet returns the metadata of the file objectPath of
+// storagezone, read from the listing of its directory.
+func (api *BunnycdnApi) StorageObjectGet(ctx context.Context, storagezone *Storagezone, objectPath string) (*StorageObject, error) {
+	objectPath = strings.Trim(objectPath, "/")
+	directoryPath, objectName := "", objectPath
+	if i := strings.LastIndex(objectPath, "/"); i >= 0 {
+		directoryPath, objectName = objectPath[:i], objectPath[i+1:]
+	}
+
+	objects, err := api.StorageObjectList(ctx, storagezone, directoryPath)
+	if err != nil {
+		storageObjectError, ok := err.(*model.StorageObjectError)
+		if ok && storageObjectError.StatusCode == 404 {
+			return nil, model.NewStorageObjectError(404, objectPath, "")
+		}
+		return nil, err
+	}
+
+	for _, item := range objects {
+		if item.ObjectName == objectName && !item.IsDirectory {
+			return &item, nil
+		}
+	}
+	return nil, model.NewStorageObjectError(404, objectPath, "")
+}
+
+func (api *BunnycdnApi) StorageObjectDelete(ctx context.Context, storagezone *Storagezone, objectPath string) error {
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("AccessKey", storagezone.Password).
+		Delete(storageObjectUrl(storagezone, objectPath))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() == 200 || response.StatusCode() == 204 {
+		return nil
+	}
+
+	return model.NewStorageObjectError(response.StatusCode(), objectPath, string(response.Body()))
+}
+
+func StorageObjectToStorageObjectDataSourceObjectModel(resource StorageObject) model.StorageObjectDataSourceObjectModel {
+	checksum := strings.ToUpper(resource.Checksum)
+	return model.StorageObjectDataSourceObjectModel{
+		Name:        types.StringValue(resource.ObjectName),
+		IsDirectory: types.BoolValue(resource.IsDirectory),
+		Size:        types.Int64Value(resource.Length),
+		Checksum:    types.StringPointerValue(ifEmptyThenNil(&checksum)),
+		ContentType: types.StringPointerValue(ifEmptyThenNil(&resource.ContentType)),
+		LastChanged: types.StringValue(resource.LastChanged),
+	}
+}
@@ -0,0 +1,250 @@
+package bunnycdn_api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Storagezone struct {
+	Id                 int64    `json:"Id"`
+	Name               string   `json:"Name"`
+	Region             string   `json:"Region"`
+	ReplicationRegions []string `json:"ReplicationRegions"`
+	ZoneTier           int64    `json:"ZoneTier"`
+	Custom404FilePath  *string  `json:"Custom404FilePath"`
+	Rewrite404To200    bool     `json:"Rewrite404To200"`
+	StorageHostname    string   `json:"StorageHostname"`
+	Password           string   `json:"Password"`
+	ReadOnlyPassword   string   `json:"ReadOnlyPassword"`
+	Deleted            bool     `json:"Deleted"`
+}
+
+func stringsToSet(values []string) types.Set {
+	elements := []attr.Value{}
+	for _, item := range values {
+		elements = append(elements, types.StringValue(item))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// SetToStrings returns the known string elements of value.
+func SetToStrings(value types.Set) []string {
+	values := []string{}
+	for _, item := range value.Elements() {
+		if item, ok := item.(types.String); ok && !item.IsUnknown() {
+			values = append(values, item.ValueString())
+		}
+	}
+	return values
+}
+
+func StoragezoneToStoragezoneResourceModel(resource *Storagezone) model.StoragezoneResourceModel {
+	return model.StoragezoneResourceModel{
+		Id:                 types.Int64Value(resource.Id),
+		Name:               types.StringValue(resource.Name),
+		Region:             types.StringValue(resource.Region),
+		ReplicationRegions: stringsToSet(resource.ReplicationRegions),
+		ZoneTier:           types.Int64Value(resource.ZoneTier),
+		Custom404FilePath:  types.StringPointerValue(ifEmptyThenNil(resource.Custom404FilePath)),
+		Rewrite404To200:    types.BoolValue(resource.Rewrite404To200),
+		StorageHostname:    types.StringValue(resource.StorageHostname),
+		Password:           types.StringValue(resource.Password),
+		ReadOnlyPassword:   types.StringValue(resource.ReadOnlyPassword),
+	}
+}
+
+func StoragezoneToStoragezoneDataSourceModel(resource *Storagezone) model.StoragezoneDataSourceModel {
+	return model.StoragezoneDataSourceModel{
+		Id:                 types.Int64Value(resource.Id),
+		Name:               types.StringValue(resource.Name),
+		Region:             types.StringValue(resource.Region),
+		ReplicationRegions: stringsToSet(resource.ReplicationRegions),
+		ZoneTier:           types.Int64Value(resource.ZoneTier),
+		StorageHostname:    types.StringValue(resource.StorageHostname),
+	}
+}
+
+func StoragezoneResourceModelToStoragezone(resource model.StoragezoneResourceModel) Storagezone {
+	return Storagezone{
+		Id:                 resource.Id.ValueInt64(),
+		Name:               resource.Name.ValueString(),
+		Region:             resource.Region.ValueString(),
+		ReplicationRegions: SetToStrings(resource.ReplicationRegions),
+		ZoneTier:           resource.ZoneTier.ValueInt64(),
+		Custom404FilePath:  resource.Custom404FilePath.ValueStringPointer(),
+		Rewrite404To200:    resource.Rewrite404To200.ValueBool(),
+	}
+}
+
+func (api *BunnycdnApi) StoragezoneGet(ctx context.Context, id int64) (*Storagezone, error) {
+	var resource Storagezone
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("AccessKey", api.ApiKey).
+		SetResult(&resource).
+		Get(fmt.Sprintf("https://api.bunny.net/storagezone/%d", id))
+
+	if err != nil {
+		return nil, err
+	}
+
+	// deleted storage zones are still returned for a while
+	if response.StatusCode() == 200 && resource.Deleted {
+		return nil, model.NewStoragezoneError(404, id, "")
+	}
+
+	if response.StatusCode() == 200 {
+		return &resource, nil
+	}
+
+	return nil, model.NewStoragezoneError(response.StatusCode(), id, string(response.Body()))
+}
+
+type StoragezoneList struct {
+	Items        []Storagezone `json:"Items"`
+	CurrentPage  int64         `json:"CurrentPage"`
+	TotalItems   int64         `json:"TotalItems"`
+	HasMoreItems bool          `json:"HasMoreItems"`
+}
+
+// StoragezoneList returns the storage zones whose name contains search, or
+// all storage zones when search is empty. All pages are fetched and deleted
+// storage zones are skipped.
+func (api *BunnycdnApi) StoragezoneList(ctx context.Context, search string) ([]Storagezone, error) {
+	var storagezones []Storagezone
+
+	for page := 1; ; page++ {
+		var resource StoragezoneList
+
+		response, err := resty.New().R().
+			SetContext(ctx).
+			SetHeader("AccessKey", api.ApiKey).
+			SetQueryParams(map[string]string{
+				"page":           strconv.Itoa(page),
+				"perPage":        "1000",
+				"search":         search,
+				"includeDeleted": "false",
+			}).
+			SetResult(&resource).
+			Get("https://api.bunny.net/storagezone")
+
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode() != 200 {
+			return nil, model.NewStoragezoneError(response.StatusCode(), 0, string(response.Body()))
+		}
+
+		for _, item := range resource.Items {
+			if !item.Deleted {
+				storagezones = append(storagezones, item)
+			}
+		}
+		if !resource.HasMoreItems || len(resource.Items) == 0 {
+			return storagezones, nil
+		}
+	}
+}
+
+func (api *BunnycdnApi) StoragezoneCreate(ctx context.Context, resource Storagezone) (*Storagezone, error) {
+	var createdResource Storagezone
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("AccessKey", api.ApiKey).
+		SetBody(map[string]interface{}{
+			"Name":               resource.Name,
+			"Region":             resource.Region,
+			"ReplicationRegions": resource.ReplicationRegions,
+			"ZoneTier":           resource.ZoneTier,
+		}).
+		SetResult(&createdResource).
+		Post("https://api.bunny.net/storagezone")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == 201 || response.StatusCode() == 200 {
+		return &createdResource, nil
+	}
+
+	return nil, model.NewStoragezoneError(response.StatusCode(), resource.Id, string(response.Body()))
+}
+
+func (api *BunnycdnApi) StoragezoneUpdate(ctx context.Context, resource Storagezone) error {
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("AccessKey", api.ApiKey).
+		SetBody(map[string]interface{}{
+			"ReplicationZones":  resource.ReplicationRegions,
+			"Custom404FilePath": resource.Custom404FilePath,
+			"Rewrite404To200":   resource.Rewrite404To200,
+		}).
+		Post(fmt.Sprintf("https://api.bunny.net/storagezone/%d", resource.Id))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() == 204 || response.StatusCode() == 200 {
+		return nil
+	}
+
+	return model.NewStoragezoneError(response.StatusCode(), resource.Id, string(response.Body()))
+}
+
+func (api *BunnycdnApi) StoragezoneDelete(ctx context.Context, resource Storagezone) error {
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("AccessKey", api.ApiKey).
+		Delete(fmt.Sprintf("https://api.bunny.net/storagezone/%d", resource.Id))
+
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode() == 204 {
+		return nil
+	}
+
+	return model.NewStoragezoneError(response.StatusCode(), resource.Id, string(response.Body()))
+}
+
+type Region struct {
+	Id         int64  `json:"Id"`
+	Name       string `json:"Name"`
+	RegionCode string `json:"RegionCode"`
+}
+
+// StoragezoneRegionList returns the regions known to bunny.net. Their codes
+// are the values accepted as main and replication regions of storage zones.
+func (api *BunnycdnApi) StoragezoneRegionList(ctx context.Context) ([]Region, error) {
+	var regions []Region
+
+	response, err := resty.New().R().
+		SetContext(ctx).
+		SetHeader("AccessKey", api.ApiKey).
+		SetResult(&regions).
+		Get("https://api.bunny.net/region")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == 200 {
+		return regions, nil
+	}
+
+	return nil, model.NewStoragezoneError(response.StatusCode(), 0, string(response.Body()))
+}
@@ -0,0 +1,166 @@
+package certificate_format
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+// Normalize converts a certificate and key given as PEM, base64 encoded PEM,
+// base64 encoded DER or a base64 encoded PKCS#12 bundle into the base64
+// encoded PEM files bunny.net expects. The key may be empty when the
+// certificate is a PKCS#12 bundle containing it.
+func Normalize(certificate string, certificateKey string, password string) (string, string, error) {
+	certificatePem, bundledKeyPem, err := normalizeCertificate(certificate, password)
+	if err != nil {
+		return "", "", err
+	}
+
+	keyPem := bundledKeyPem
+	if certificateKey != "" {
+		keyPem, err = normalizeKey(certificateKey)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	if keyPem == nil {
+		return "", "", errors.New("certificate_key is required unless the certificate is a PKCS#12 bundle containing the key")
+	}
+
+	_, err = tls.X509KeyPair(certificatePem, keyPem)
+	if err != nil {
+		return "", "", fmt.Errorf("certificate and key do not form a valid pair: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(certificatePem), base64.StdEncoding.EncodeToString(keyPem), nil
+}
+
+func normalizeCertificate(value string, password string) ([]byte, []byte, error) {
+	data, err := decode(value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unsupported certificate encoding, expected PEM, base64 DER or base64 PKCS#12: %w", err)
+	}
+
+	if isPem(data) {
+		var certificates []*x509.Certificate
+		for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid PEM certificate: %w", err)
+			}
+			certificates = append(certificates, certificate)
+		}
+		if len(certificates) == 0 {
+			return nil, nil, errors.New("PEM certificate does not contain a CERTIFICATE block")
+		}
+		return encodeCertificates(certificates), nil, nil
+	}
+
+	certificates, err := x509.ParseCertificates(data)
+	if err == nil && len(certificates) > 0 {
+		return encodeCertificates(certificates), nil, nil
+	}
+
+	blocks, err := pkcs12.ToPEM(data, password)
+	if err != nil {
+		if errors.Is(err, pkcs12.ErrIncorrectPassword) {
+			return nil, nil, errors.New("incorrect PKCS#12 certificate_password")
+		}
+		return nil, nil, errors.New("unsupported certificate encoding, expected PEM, base64 DER or base64 PKCS#12")
+	}
+
+	var certificatePem, keyPem []byte
+	for _, block := range blocks {
+		switch block.Type {
+		case "CERTIFICATE":
+			certificatePem = append(certificatePem, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: block.Bytes})...)
+		case "PRIVATE KEY":
+			keyPem, err = encodeKey(block.Bytes)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid PKCS#12 private key: %w", err)
+			}
+		}
+	}
+	if certificatePem == nil {
+		return nil, nil, errors.New("PKCS#12 bundle does not contain a certificate")
+	}
+	return certificatePem, keyPem, nil
+}
+
+func normalizeKey(value string) ([]byte, error) {
+	data, err := decode(value)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported certificate_key encoding, expected PEM or base64 DER: %w", err)
+	}
+
+	if isPem(data) {
+		for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+			if strings.HasSuffix(block.Type, "PRIVATE KEY") {
+				return encodeKey(block.Bytes)
+			}
+		}
+		return nil, errors.New("PEM certificate_key does not contain a PRIVATE KEY block")
+	}
+
+	return encodeKey(data)
+}
+
+// decode returns PEM input as is and decodes any other input as base64.
+func decode(value string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	if isPem([]byte(value)) {
+		return []byte(value), nil
+	}
+
+	value = strings.Join(strings.Fields(value), "")
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		data, err := encoding.DecodeString(value)
+		if err == nil {
+			return data, nil
+		}
+	}
+	return nil, errors.New("value is neither PEM nor base64")
+}
+
+func isPem(data []byte) bool {
+	return bytes.Contains(data, []byte("-----BEGIN "))
+}
+
+func encodeCertificates(certificates []*x509.Certificate) []byte {
+	var encoded []byte
+	for _, certificate := range certificates {
+		encoded = append(encoded, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw})...)
+	}
+	return encoded
+}
+
+// encodeKey parses a PKCS#8, PKCS#1 or SEC 1 DER key and encodes it as a
+// PKCS#8 PEM block.
+func encodeKey(der []byte) ([]byte, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		key, err = x509.ParsePKCS1PrivateKey(der)
+	}
+	if err != nil {
+		key, err = x509.ParseECPrivateKey(der)
+	}
+	if err != nil {
+		return nil, errors.New("private key is not a PKCS#8, PKCS#1 or EC key")
+	}
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}), nil
+}
@@ -0,0 +1,129 @@
+package certificate_format
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"strings"
+	"testing"
+)
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func derOf(t *testing.T, data []byte) []byte {
+	t.Helper()
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatal("no PEM block")
+	}
+	return block.Bytes
+}
+
+func TestNormalize(t *testing.T) {
+	certificatePem := readTestdata(t, "certificate.pem")
+	keyPem := readTestdata(t, "key.pem")
+	bundle := base64.StdEncoding.EncodeToString(readTestdata(t, "certificate.p12"))
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKeyDer, err := x509.MarshalPKCS8PrivateKey(otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		certificate    string
+		certificateKey string
+		password       string
+		err            string
+	}{
+		{
+			name:           "PEM",
+			certificate:    string(certificatePem),
+			certificateKey: string(keyPem),
+		},
+		{
+			name:           "base64 PEM",
+			certificate:    base64.StdEncoding.EncodeToString(certificatePem),
+			certificateKey: base64.StdEncoding.EncodeToString(keyPem),
+		},
+		{
+			name:           "base64 DER",
+			certificate:    base64.StdEncoding.EncodeToString(derOf(t, certificatePem)),
+			certificateKey: base64.StdEncoding.EncodeToString(derOf(t, keyPem)),
+		},
+		{
+			name:        "PKCS#12",
+			certificate: bundle,
+			password:    "secret",
+		},
+		{
+			name:        "PKCS#12 with wrong password",
+			certificate: bundle,
+			password:    "wrong",
+			err:         "incorrect PKCS#12 certificate_password",
+		},
+		{
+			name:        "PEM without key",
+			certificate: string(certificatePem),
+			err:         "certificate_key is required",
+		},
+		{
+			name:           "mismatched key",
+			certificate:    string(certificatePem),
+			certificateKey: base64.StdEncoding.EncodeToString(otherKeyDer),
+			err:            "do not form a valid pair",
+		},
+		{
+			name:           "not a certificate",
+			certificate:    "not a certificate!",
+			certificateKey: string(keyPem),
+			err:            "unsupported certificate encoding",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			certificate, certificateKey, err := Normalize(test.certificate, test.certificateKey, test.password)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected error containing %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the result is base64 encoded PEM of the same certificate and key
+			decodedCertificate, err := base64.StdEncoding.DecodeString(certificate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(decodedCertificate) != string(certificatePem) {
+				t.Errorf("unexpected certificate:\n%s", decodedCertificate)
+			}
+			decodedKey, err := base64.StdEncoding.DecodeString(certificateKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(decodedKey) != string(keyPem) {
+				t.Errorf("unexpected key:\n%s", decodedKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AcmeCertificateResourceModel struct {
+	PullzoneId              types.Int64  `tfsdk:"pullzone_id"`
+	Id                      types.Int64  `tfsdk:"id"`
+	Hostname                types.String `tfsdk:"hostname"`
+	SubjectAlternativeNames types.List   `tfsdk:"subject_alternative_names"`
+	DnsZoneId               types.Int64  `tfsdk:"dns_zone_id"`
+	DirectoryUrl            types.String `tfsdk:"directory_url"`
+	DirectoryCaCertificate  types.String `tfsdk:"directory_ca_certificate"`
+	Email                   types.String `tfsdk:"email"`
+	RenewBeforeDays         types.Int64  `tfsdk:"renew_before_days"`
+	DnsPropagationWait      types.Int64  `tfsdk:"dns_propagation_wait"`
+	AccountKeyPem           types.String `tfsdk:"account_key_pem"`
+	CertificatePem          types.String `tfsdk:"certificate_pem"`
+	PrivateKeyPem           types.String `tfsdk:"private_key_pem"`
+	NotAfter                types.String `tfsdk:"not_after"`
+}
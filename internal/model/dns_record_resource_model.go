@@ -0,0 +1,62 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DnsRecordResourceModel struct {
+	Id                   types.Int64   `tfsdk:"id"`
+	ZoneId               types.Int64   `tfsdk:"zone_id"`
+	Name                 types.String  `tfsdk:"name"`
+	Type                 types.String  `tfsdk:"type"`
+	Value                types.String  `tfsdk:"value"`
+	Ttl                  types.Int64   `tfsdk:"ttl"`
+	Weight               types.Int64   `tfsdk:"weight"`
+	Priority             types.Int64   `tfsdk:"priority"`
+	Port                 types.Int64   `tfsdk:"port"`
+	Flags                types.Int64   `tfsdk:"flags"`
+	Tag                  types.String  `tfsdk:"tag"`
+	PullzoneId           types.Int64   `tfsdk:"pullzone_id"`
+	ScriptId             types.Int64   `tfsdk:"script_id"`
+	SmartRoutingType     types.String  `tfsdk:"smart_routing_type"`
+	LatencyZone          types.String  `tfsdk:"latency_zone"`
+	GeolocationLatitude  types.Float64 `tfsdk:"geolocation_latitude"`
+	GeolocationLongitude types.Float64 `tfsdk:"geolocation_longitude"`
+	MonitorType          types.String  `tfsdk:"monitor_type"`
+	MonitorStatus        types.String  `tfsdk:"monitor_status"`
+	LinkName             types.String  `tfsdk:"link_name"`
+}
+
+type DnsRecordError struct {
+	StatusCode int
+	DnsZoneId  int64
+	Name       string
+	Body       string
+}
+
+func NewDnsRecordError(statusCode int, dnsZoneId int64, name string, body string) *DnsRecordError {
+	return &DnsRecordError{
+		StatusCode: statusCode,
+		DnsZoneId:  dnsZoneId,
+		Name:       name,
+		Body:       body,
+	}
+}
+
+func (e *DnsRecordError) Error() string {
+	if e.StatusCode == 400 {
+		return fmt.Sprintf("Invalid DNS record %s. response: %s", e.Name, e.Body)
+	}
+	if e.StatusCode == 401 {
+		return "Request authorization failed"
+	}
+	if e.StatusCode == 404 {
+		return fmt.Sprintf("DNS record %s does not exist in DNS zone with ID %d", e.Name, e.DnsZoneId)
+	}
+	if e.StatusCode >= 500 {
+		return fmt.Sprintf("Bunnycdn server error. status code: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("Unexpected status code %d", e.StatusCode)
+}
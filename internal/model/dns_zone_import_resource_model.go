@@ -0,0 +1,15 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DnsZoneImportResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ZoneId      types.Int64  `tfsdk:"zone_id"`
+	Content     types.String `tfsdk:"content"`
+	DefaultTtl  types.Int64  `tfsdk:"default_ttl"`
+	Records     types.Map    `tfsdk:"records"`
+	RecordCount types.Int64  `tfsdk:"record_count"`
+	Unsupported types.List   `tfsdk:"unsupported"`
+}
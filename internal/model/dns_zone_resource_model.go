@@ -0,0 +1,49 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DnsZoneResourceModel struct {
+	Id                            types.Int64  `tfsdk:"id"`
+	Domain                        types.String `tfsdk:"domain"`
+	CustomNameserversEnabled      types.Bool   `tfsdk:"custom_nameservers_enabled"`
+	Nameserver1                   types.String `tfsdk:"nameserver_1"`
+	Nameserver2                   types.String `tfsdk:"nameserver_2"`
+	SoaEmail                      types.String `tfsdk:"soa_email"`
+	LoggingEnabled                types.Bool   `tfsdk:"logging_enabled"`
+	LoggingIpAnonymizationEnabled types.Bool   `tfsdk:"logging_ip_anonymization_enabled"`
+	LogAnonymizationType          types.String `tfsdk:"log_anonymization_type"`
+	Nameservers                   types.List   `tfsdk:"nameservers"`
+	NameserversDetected           types.Bool   `tfsdk:"nameservers_detected"`
+}
+
+type DnsZoneError struct {
+	StatusCode int
+	DnsZoneId  int64
+}
+
+func NewDnsZoneError(statusCode int, dnsZoneId int64) *DnsZoneError {
+	return &DnsZoneError{
+		StatusCode: statusCode,
+		DnsZoneId:  dnsZoneId,
+	}
+}
+
+func (e *DnsZoneError) Error() string {
+	if e.StatusCode == 400 {
+		return "Invalid request"
+	}
+	if e.StatusCode == 401 {
+		return "Request authorization failed"
+	}
+	if e.StatusCode == 404 {
+		return fmt.Sprintf("DNS zone with ID %d does not exist", e.DnsZoneId)
+	}
+	if e.StatusCode >= 500 {
+		return fmt.Sprintf("Bunnycdn server error. status code: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("Unexpected status code %d", e.StatusCode)
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type EdgeRuleResourceModel struct {
+	Id                  types.String           `tfsdk:"id"`
+	PullzoneId          types.Int64            `tfsdk:"pullzone_id"`
+	ActionType          types.String           `tfsdk:"action_type"`
+	ActionParameter1    types.String           `tfsdk:"action_parameter_1"`
+	ActionParameter2    types.String           `tfsdk:"action_parameter_2"`
+	Triggers            []EdgeRuleTriggerModel `tfsdk:"triggers"`
+	TriggerMatchingType types.Int64            `tfsdk:"trigger_matching_type"`
+	Enabled             types.Bool             `tfsdk:"enabled"`
+	Description         types.String           `tfsdk:"description"`
+}
+
+type EdgeRuleTriggerModel struct {
+	Type                types.String   `tfsdk:"type"`
+	PatternMatches      []types.String `tfsdk:"pattern_matches"`
+	PatternMatchingType types.Int64    `tfsdk:"pattern_matching_type"`
+	Parameter1          types.String   `tfsdk:"parameter_1"`
+}
+
+type EdgeRuleError struct {
+	StatusCode int
+	PullzoneId int64
+	Guid       string
+	Body       string
+}
+
+func NewEdgeRuleError(statusCode int, pullzoneId int64, guid string, body string) *EdgeRuleError {
+	return &EdgeRuleError{
+		StatusCode: statusCode,
+		PullzoneId: pullzoneId,
+		Guid:       guid,
+		Body:       body,
+	}
+}
+
+func (e *EdgeRuleError) Error() string {
+	if e.StatusCode == 400 {
+		return fmt.Sprintf("Invalid edge rule. response: %s", e.Body)
+	}
+	if e.StatusCode == 401 {
+		return "Request authorization failed"
+	}
+	if e.StatusCode == 404 {
+		return fmt.Sprintf("Edge rule %s of pull zone %d does not exist", e.Guid, e.PullzoneId)
+	}
+	if e.StatusCode >= 500 {
+		return fmt.Sprintf("Bunnycdn server error. status code: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("Unexpected status code %d", e.StatusCode)
+}
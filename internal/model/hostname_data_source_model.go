@@ -0,0 +1,13 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type HostnameDataSourceModel struct {
+	PullzoneId     types.Int64  `tfsdk:"pullzone_id"`
+	Id             types.Int64  `tfsdk:"id"`
+	Hostname       types.String `tfsdk:"hostname"`
+	HasCertificate types.Bool   `tfsdk:"has_certificate"`
+	ForceSsl       types.Bool   `tfsdk:"force_ssl"`
+}
@@ -7,13 +7,17 @@ import (
 )
 
 type HostnameResourceModel struct {
-	PullzoneId     types.Int64  `tfsdk:"pullzone_id"`
-	Id             types.Int64  `tfsdk:"id"`
-	Hostname       types.String `tfsdk:"hostname"`
-	EnableSsl      types.Bool   `tfsdk:"enable_ssl"`
-	ForceSsl       types.Bool   `tfsdk:"force_ssl"`
-	Certificate    types.String `tfsdk:"certificate"`
-	CertificateKey types.String `tfsdk:"certificate_key"`
+	PullzoneId          types.Int64  `tfsdk:"pullzone_id"`
+	Id                  types.Int64  `tfsdk:"id"`
+	Hostname            types.String `tfsdk:"hostname"`
+	EnableSsl           types.Bool   `tfsdk:"enable_ssl"`
+	ForceSsl            types.Bool   `tfsdk:"force_ssl"`
+	Certificate         types.String `tfsdk:"certificate"`
+	CertificateKey      types.String `tfsdk:"certificate_key"`
+	CertificatePassword types.String `tfsdk:"certificate_password"`
+	DnsZoneId           types.Int64  `tfsdk:"dns_zone_id"`
+	DnsRecordType       types.String `tfsdk:"dns_record_type"`
+	DnsRecordId         types.Int64  `tfsdk:"dns_record_id"`
 }
 
 type HostnameError struct {
@@ -0,0 +1,14 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PullzoneCertificateResourceModel struct {
+	PullzoneId          types.Int64  `tfsdk:"pullzone_id"`
+	Id                  types.Int64  `tfsdk:"id"`
+	Hostname            types.String `tfsdk:"hostname"`
+	Certificate         types.String `tfsdk:"certificate"`
+	CertificateKey      types.String `tfsdk:"certificate_key"`
+	CertificatePassword types.String `tfsdk:"certificate_password"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PullzoneDataSourceModel struct {
+	Id                        types.Int64  `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	OriginType                types.Int64  `tfsdk:"origin_type"`
+	StorageZoneId             types.Int64  `tfsdk:"storage_zone_id"`
+	OriginUrl                 types.String `tfsdk:"origin_url"`
+	OriginHostHeader          types.String `tfsdk:"origin_host_header"`
+	EnableSmartCache          types.Bool   `tfsdk:"enable_smart_cache"`
+	DisableCookies            types.Bool   `tfsdk:"disable_cookie"`
+	ErrorPageEnableCustomCode types.Bool   `tfsdk:"error_page_enable_custom_code"`
+	ErrorPageCustomCode       types.String `tfsdk:"error_page_custom_code"`
+	Hostnames                 types.List   `tfsdk:"hostnames"`
+	CdnDomain                 types.String `tfsdk:"cdn_domain"`
+}
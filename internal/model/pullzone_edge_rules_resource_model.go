@@ -0,0 +1,22 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PullzoneEdgeRulesResourceModel struct {
+	Id         types.Int64                  `tfsdk:"id"`
+	PullzoneId types.Int64                  `tfsdk:"pullzone_id"`
+	Rules      []PullzoneEdgeRulesRuleModel `tfsdk:"rules"`
+}
+
+type PullzoneEdgeRulesRuleModel struct {
+	Id                  types.String           `tfsdk:"id"`
+	ActionType          types.String           `tfsdk:"action_type"`
+	ActionParameter1    types.String           `tfsdk:"action_parameter_1"`
+	ActionParameter2    types.String           `tfsdk:"action_parameter_2"`
+	Triggers            []EdgeRuleTriggerModel `tfsdk:"triggers"`
+	TriggerMatchingType types.Int64            `tfsdk:"trigger_matching_type"`
+	Enabled             types.Bool             `tfsdk:"enabled"`
+	Description         types.String           `tfsdk:"description"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PullzoneHostnamesResourceModel struct {
+	Id              types.Int64                               `tfsdk:"id"`
+	PullzoneId      types.Int64                               `tfsdk:"pullzone_id"`
+	Hostnames       map[string]PullzoneHostnamesHostnameModel `tfsdk:"hostnames"`
+	RemoveUnmanaged types.Bool                                `tfsdk:"remove_unmanaged"`
+}
+
+type PullzoneHostnamesHostnameModel struct {
+	Id        types.Int64 `tfsdk:"id"`
+	EnableSsl types.Bool  `tfsdk:"enable_ssl"`
+	ForceSsl  types.Bool  `tfsdk:"force_ssl"`
+}
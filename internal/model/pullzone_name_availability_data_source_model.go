@@ -0,0 +1,10 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PullzoneNameAvailabilityDataSourceModel struct {
+	Name      types.String `tfsdk:"name"`
+	Available types.Bool   `tfsdk:"available"`
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,6 +18,19 @@ type PullzoneResourceModel struct {
 	DisableCookies            types.Bool   `tfsdk:"disable_cookie"`
 	ErrorPageEnableCustomCode types.Bool   `tfsdk:"error_page_enable_custom_code"`
 	ErrorPageCustomCode       types.String `tfsdk:"error_page_custom_code"`
+	Hostnames                 types.List   `tfsdk:"hostnames"`
+	CdnDomain                 types.String `tfsdk:"cdn_domain"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
+}
+
+var PullzoneHostnameModelType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                 types.Int64Type,
+		"value":              types.StringType,
+		"has_certificate":    types.BoolType,
+		"force_ssl":          types.BoolType,
+		"is_system_hostname": types.BoolType,
+	},
 }
 
 type PullzoneError struct {
@@ -0,0 +1,12 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PullzonesDataSourceModel struct {
+	NameRegex  types.String              `tfsdk:"name_regex"`
+	OriginType types.Int64               `tfsdk:"origin_type"`
+	Hostname   types.String              `tfsdk:"hostname"`
+	Pullzones  []PullzoneDataSourceModel `tfsdk:"pullzones"`
+}
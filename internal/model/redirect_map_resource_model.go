@@ -0,0 +1,18 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RedirectMapResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	PullzoneId types.Int64  `tfsdk:"pullzone_id"`
+	Name       types.String `tfsdk:"name"`
+	Redirects  types.Map    `tfsdk:"redirects"`
+	CsvFile    types.String `tfsdk:"csv_file"`
+	StatusCode types.Int64  `tfsdk:"status_code"`
+	Hostname   types.String `tfsdk:"hostname"`
+	RuleIds    types.List   `tfsdk:"rule_ids"`
+	RuleCount  types.Int64  `tfsdk:"rule_count"`
+	Checksum   types.String `tfsdk:"checksum"`
+}
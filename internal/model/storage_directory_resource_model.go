@@ -0,0 +1,14 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageDirectoryResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	StoragezoneId types.Int64  `tfsdk:"storagezone_id"`
+	Source        types.String `tfsdk:"source"`
+	Prefix        types.String `tfsdk:"prefix"`
+	Concurrency   types.Int64  `tfsdk:"concurrency"`
+	Files         types.Map    `tfsdk:"files"`
+}
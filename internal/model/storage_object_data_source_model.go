@@ -0,0 +1,27 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageObjectDataSourceModel struct {
+	StoragezoneId types.Int64                          `tfsdk:"storagezone_id"`
+	Path          types.String                         `tfsdk:"path"`
+	MaxSize       types.Int64                          `tfsdk:"max_size"`
+	Content       types.String                         `tfsdk:"content"`
+	ContentBase64 types.String                         `tfsdk:"content_base64"`
+	Size          types.Int64                          `tfsdk:"size"`
+	Checksum      types.String                         `tfsdk:"checksum"`
+	ContentType   types.String                         `tfsdk:"content_type"`
+	LastChanged   types.String                         `tfsdk:"last_changed"`
+	Objects       []StorageObjectDataSourceObjectModel `tfsdk:"objects"`
+}
+
+type StorageObjectDataSourceObjectModel struct {
+	Name        types.String `tfsdk:"name"`
+	IsDirectory types.Bool   `tfsdk:"is_directory"`
+	Size        types.Int64  `tfsdk:"size"`
+	Checksum    types.String `tfsdk:"checksum"`
+	ContentType types.String `tfsdk:"content_type"`
+	LastChanged types.String `tfsdk:"last_changed"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StorageObjectResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	StoragezoneId types.Int64  `tfsdk:"storagezone_id"`
+	Path          types.String `tfsdk:"path"`
+	Content       types.String `tfsdk:"content"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	Source        types.String `tfsdk:"source"`
+	Checksum      types.String `tfsdk:"checksum"`
+}
+
+type StorageObjectError struct {
+	StatusCode int
+	Path       string
+	Body       string
+}
+
+func NewStorageObjectError(statusCode int, path string, body string) *StorageObjectError {
+	return &StorageObjectError{
+		StatusCode: statusCode,
+		Path:       path,
+		Body:       body,
+	}
+}
+
+func (e *StorageObjectError) Error() string {
+	if e.StatusCode == 400 {
+		return fmt.Sprintf("Invalid request. response: %s", e.Body)
+	}
+	if e.StatusCode == 401 {
+		return "Request authorization failed"
+	}
+	if e.StatusCode == 404 {
+		return fmt.Sprintf("Storage object %s does not exist", e.Path)
+	}
+	if e.StatusCode >= 500 {
+		return fmt.Sprintf("Bunnycdn server error. status code: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("Unexpected status code %d", e.StatusCode)
+}
@@ -0,0 +1,14 @@
+package model
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StoragezoneDataSourceModel struct {
+	Id                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Region             types.String `tfsdk:"region"`
+	ReplicationRegions types.Set    `tfsdk:"replication_regions"`
+	ZoneTier           types.Int64  `tfsdk:"zone_tier"`
+	StorageHostname    types.String `tfsdk:"storage_hostname"`
+}
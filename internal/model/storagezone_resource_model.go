@@ -0,0 +1,50 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type StoragezoneResourceModel struct {
+	Id                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Region             types.String `tfsdk:"region"`
+	ReplicationRegions types.Set    `tfsdk:"replication_regions"`
+	ZoneTier           types.Int64  `tfsdk:"zone_tier"`
+	Custom404FilePath  types.String `tfsdk:"custom_404_file_path"`
+	Rewrite404To200    types.Bool   `tfsdk:"rewrite_404_to_200"`
+	StorageHostname    types.String `tfsdk:"storage_hostname"`
+	Password           types.String `tfsdk:"password"`
+	ReadOnlyPassword   types.String `tfsdk:"read_only_password"`
+}
+
+type StoragezoneError struct {
+	StatusCode    int
+	StoragezoneId int64
+	Body          string
+}
+
+func NewStoragezoneError(statusCode int, storagezoneId int64, body string) *StoragezoneError {
+	return &StoragezoneError{
+		StatusCode:    statusCode,
+		StoragezoneId: storagezoneId,
+		Body:          body,
+	}
+}
+
+func (e *StoragezoneError) Error() string {
+	if e.StatusCode == 400 {
+		return fmt.Sprintf("Invalid request. response: %s", e.Body)
+	}
+	if e.StatusCode == 401 {
+		return "Request authorization failed"
+	}
+	if e.StatusCode == 404 {
+		return fmt.Sprintf("Storage zone with ID %d does not exist", e.StoragezoneId)
+	}
+	if e.StatusCode >= 500 {
+		return fmt.Sprintf("Bunnycdn server error. status code: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("Unexpected status code %d", e.StatusCode)
+}
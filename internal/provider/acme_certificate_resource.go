@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"terraform-provider-bunnycdn/internal/acme_client"
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/certificate_format"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/acme"
+)
+
+var _ resource.Resource = &AcmeCertificateResource{}
+var _ resource.ResourceWithModifyPlan = &AcmeCertificateResource{}
+
+func NewAcmeCertificateResource() resource.Resource {
+	return &AcmeCertificateResource{}
+}
+
+type AcmeCertificateResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (r *AcmeCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_certificate"
+}
+
+func (r *AcmeCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Certificate obtained from an ACME directory and installed on a pull zone hostname. " +
+			"Challenges are solved with DNS-01 records in a Bunny DNS zone, which allows wildcard names. " +
+			"The certificate is renewed on the first plan within `renew_before_days` of its expiry.",
+
+		Attributes: map[string]schema.Attribute{
+			"pullzone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the pull zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname the certificate is installed on. It is also the common name of the certificate.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subject_alternative_names": schema.ListAttribute{
+				MarkdownDescription: "Additional names of the certificate, e.g. `*.cdn.example.com`",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_zone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the Bunny DNS zone the DNS-01 challenge records are created in",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"directory_url": schema.StringAttribute{
+				MarkdownDescription: "The ACME directory URL. Defaults to the Let's Encrypt production directory",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(acme.LetsEncryptURL),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory_ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate trusted when connecting to the ACME directory, e.g. of a local Pebble instance",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Contact email of the ACME account",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"renew_before_days": schema.Int64Attribute{
+				MarkdownDescription: "Renew the certificate when it expires in less than this number of days",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(30),
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"dns_propagation_wait": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait after creating a challenge record before asking the ACME server to validate it",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(30),
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"account_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the ACME account",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate chain",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the certificate",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "Expiry time of the certificate in RFC 3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the hostname",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AcmeCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *AcmeCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to renew on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, state model.AcmeCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.RenewBeforeDays.IsUnknown() {
+		return
+	}
+
+	notAfter, err := time.Parse(time.RFC3339, state.NotAfter.ValueString())
+	if err == nil && time.Until(notAfter) > time.Duration(data.RenewBeforeDays.ValueInt64())*24*time.Hour {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate_pem"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("private_key_pem"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("not_after"), types.StringUnknown())...)
+}
+
+func (r *AcmeCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.AcmeCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.obtain(ctx, &data, "", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteResource, err := r.api.HostnameGet(ctx, data.PullzoneId.ValueInt64(), data.Hostname.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hostname, got error: %s", err))
+		return
+	}
+
+	data.Id = types.Int64Value(remoteResource.Id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AcmeCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.AcmeCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteResource, err := r.api.HostnameGet(ctx, data.PullzoneId.ValueInt64(), data.Hostname.ValueString())
+	if err != nil {
+		hostnameError, ok := err.(*model.HostnameError)
+		if ok && hostnameError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hostname, got error: %s", err))
+		return
+	}
+
+	// the certificate was removed outside of terraform
+	if !remoteResource.EnableSsl {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.Int64Value(remoteResource.Id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AcmeCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state model.AcmeCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// ModifyPlan marks the certificate unknown when it is due for renewal
+	if data.CertificatePem.IsUnknown() {
+		r.obtain(ctx, &data, state.AccountKeyPem.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AcmeCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.AcmeCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.HostnameDeleteCertificate(ctx, data.PullzoneId.ValueInt64(), bunnycdn_api.Hostname{Hostname: data.Hostname.ValueString()})
+	if err != nil {
+		deleteCertificateError, ok := err.(*model.DeleteCertificateError)
+		if ok && deleteCertificateError.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete certificate, got error: %s", err))
+		return
+	}
+}
+
+// obtain issues a certificate for data, installs it on the hostname and
+// stores the result in data.
+func (r *AcmeCertificateResource) obtain(ctx context.Context, data *model.AcmeCertificateResourceModel, accountKeyPem string, diagnostics *diag.Diagnostics) {
+	zone, err := r.api.DnsZoneGet(ctx, data.DnsZoneId.ValueInt64())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS zone, got error: %s", err))
+		return
+	}
+
+	domains := []string{data.Hostname.ValueString()}
+	for _, item := range data.SubjectAlternativeNames.Elements() {
+		name, ok := item.(types.String)
+		if ok && !name.IsNull() && !name.IsUnknown() {
+			domains = append(domains, name.ValueString())
+		}
+	}
+
+	certificate, err := acme_client.Obtain(ctx, acme_client.Request{
+		DirectoryUrl:    data.DirectoryUrl.ValueString(),
+		Email:           data.Email.ValueString(),
+		CaCertificate:   data.DirectoryCaCertificate.ValueString(),
+		AccountKeyPem:   accountKeyPem,
+		Domains:         domains,
+		PropagationWait: time.Duration(data.DnsPropagationWait.ValueInt64()) * time.Second,
+	}, &bunnyDnsSolver{api: r.api, zone: zone})
+	if err != nil {
+		diagnostics.AddError("ACME Error", fmt.Sprintf("Unable to obtain certificate, got error: %s", err))
+		return
+	}
+
+	certificatePem, privateKeyPem, err := certificate_format.Normalize(certificate.CertificatePem, certificate.PrivateKeyPem, "")
+	if err != nil {
+		diagnostics.AddError("ACME Error", fmt.Sprintf("Unable to encode certificate, got error: %s", err))
+		return
+	}
+	err = r.api.HostnameAddCertificate(ctx, data.PullzoneId.ValueInt64(), bunnycdn_api.Hostname{
+		Hostname:       data.Hostname.ValueString(),
+		Certificate:    &certificatePem,
+		CertificateKey: &privateKeyPem,
+	})
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add certificate, got error: %s", err))
+		return
+	}
+
+	data.AccountKeyPem = types.StringValue(certificate.AccountKeyPem)
+	data.CertificatePem = types.StringValue(certificate.CertificatePem)
+	data.PrivateKeyPem = types.StringValue(certificate.PrivateKeyPem)
+	data.NotAfter = types.StringValue(certificate.NotAfter.UTC().Format(time.RFC3339))
+}
+
+// bunnyDnsSolver solves DNS-01 challenges with TXT records in a Bunny DNS zone.
+type bunnyDnsSolver struct {
+	api     *bunnycdn_api.BunnycdnApi
+	zone    *bunnycdn_api.DnsZone
+	mutex   sync.Mutex
+	records map[string]bunnycdn_api.DnsRecord
+}
+
+func (s *bunnyDnsSolver) Present(ctx context.Context, fqdn string, value string) error {
+	if !strings.HasSuffix(fqdn, "."+s.zone.Domain) {
+		return fmt.Errorf("%s is not part of DNS zone %s", fqdn, s.zone.Domain)
+	}
+
+	record, err := s.api.DnsRecordCreate(ctx, s.zone.Id, bunnycdn_api.DnsRecord{
+		Type:  bunnycdn_api.DnsRecordTypeTxt,
+		Ttl:   60,
+		Name:  strings.TrimSuffix(fqdn, "."+s.zone.Domain),
+		Value: value,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.records == nil {
+		s.records = map[string]bunnycdn_api.DnsRecord{}
+	}
+	s.records[fqdn+" "+value] = *record
+	return nil
+}
+
+func (s *bunnyDnsSolver) CleanUp(ctx context.Context, fqdn string, value string) error {
+	s.mutex.Lock()
+	record, ok := s.records[fqdn+" "+value]
+	delete(s.records, fqdn+" "+value)
+	s.mutex.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return s.api.DnsRecordDelete(ctx, s.zone.Id, record)
+}
@@ -0,0 +1,532 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &DnsRecordResource{}
+var _ resource.ResourceWithImportState = &DnsRecordResource{}
+var _ resource.ResourceWithValidateConfig = &DnsRecordResource{}
+
+func NewDnsRecordResource() resource.Resource {
+	return &DnsRecordResource{}
+}
+
+type DnsRecordResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+// dnsRecordType lists what a record type takes. value checks the value, a
+// nil value means the type takes no value.
+type dnsRecordType struct {
+	value    valueCheck
+	priority bool
+	port     bool
+	caa      bool
+	pullzone bool
+	script   bool
+	monitor  bool
+}
+
+var dnsRecordTypes = map[string]dnsRecordType{
+	"A":        {value: dnsRecordIpv4, monitor: true},
+	"AAAA":     {value: dnsRecordIpv6, monitor: true},
+	"CNAME":    {value: dnsRecordHostname, monitor: true},
+	"TXT":      {value: validateNotEmpty},
+	"MX":       {value: dnsRecordHostname, priority: true},
+	"Redirect": {value: validateUrl},
+	"Flatten":  {value: dnsRecordHostname},
+	"PullZone": {pullzone: true},
+	"SRV":      {value: dnsRecordHostname, priority: true, port: true},
+	"CAA":      {value: validateNotEmpty, caa: true},
+	"PTR":      {value: dnsRecordHostname},
+	"Script":   {script: true},
+	"NS":       {value: dnsRecordHostname},
+}
+
+var dnsRecordCaaTags = map[string]bool{
+	"issue":     true,
+	"issuewild": true,
+	"iodef":     true,
+}
+
+func dnsRecordIpv4(value string) string {
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() == nil {
+		return "must be an IPv4 address"
+	}
+	return ""
+}
+
+func dnsRecordIpv6(value string) string {
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() != nil {
+		return "must be an IPv6 address"
+	}
+	return ""
+}
+
+func dnsRecordHostname(value string) string {
+	if value == "" || strings.ContainsAny(value, " /:@") {
+		return "must be a hostname"
+	}
+	return ""
+}
+
+func (r *DnsRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+func (r *DnsRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Bunny DNS record resource",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the DNS zone of the record. Changing it creates a new record",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the record relative to the domain of the DNS zone, empty for the domain itself",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the record, one of " + typeNames(bunnycdn_api.DnsRecordTypes) + ". Changing it creates a new record",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the record, e.g. the address of an `A` record or the target of a `CNAME`, `MX` or `SRV` record. Not used by `PullZone` and `Script` records",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "The TTL of the record in seconds",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"weight": schema.Int64Attribute{
+				MarkdownDescription: "The weight of the record when several records share a name and type",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(100),
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "The priority of an `MX` or `SRV` record",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "The port of an `SRV` record",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"flags": schema.Int64Attribute{
+				MarkdownDescription: "The flags of a `CAA` record",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "The tag of a `CAA` record, one of `issue`, `issuewild` or `iodef`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pullzone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the pull zone a `PullZone` record points to",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"script_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the edge script a `Script` record points to",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"smart_routing_type": schema.StringAttribute{
+				MarkdownDescription: "How bunny.net picks between records of the same name, one of " + typeNames(bunnycdn_api.DnsRecordSmartRoutingTypes),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"latency_zone": schema.StringAttribute{
+				MarkdownDescription: "The region of the record for `latency` smart routing",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"geolocation_latitude": schema.Float64Attribute{
+				MarkdownDescription: "The latitude of the record for `geolocation` smart routing",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"geolocation_longitude": schema.Float64Attribute{
+				MarkdownDescription: "The longitude of the record for `geolocation` smart routing",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor_type": schema.StringAttribute{
+				MarkdownDescription: "How bunny.net monitors the value of an `A`, `AAAA` or `CNAME` record, one of " + typeNames(bunnycdn_api.DnsRecordMonitorTypes),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"monitor_status": schema.StringAttribute{
+				MarkdownDescription: "The monitor status of the record, `unknown`, `online` or `offline`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"link_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the pull zone or edge script a `PullZone` or `Script` record points to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the record",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DnsRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *DnsRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.DnsRecordResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() {
+		return
+	}
+
+	recordType := data.Type.ValueString()
+	rules, ok := dnsRecordTypes[recordType]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Validation Error",
+			fmt.Sprintf("unknown type %s, expected one of %s", recordType, typeNames(bunnycdn_api.DnsRecordTypes)))
+		return
+	}
+
+	if rules.value == nil {
+		if !data.Value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("value"), "Validation Error", fmt.Sprintf("type %s does not take value", recordType))
+		}
+	} else if data.Value.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Validation Error", fmt.Sprintf("type %s needs value", recordType))
+	} else if !data.Value.IsUnknown() {
+		if message := rules.value(data.Value.ValueString()); message != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("value"), "Validation Error", fmt.Sprintf("value of type %s %s", recordType, message))
+		}
+	}
+
+	for _, item := range []struct {
+		name     string
+		value    attr.Value
+		allowed  bool
+		required bool
+	}{
+		{"priority", data.Priority, rules.priority, false},
+		{"port", data.Port, rules.port, rules.port},
+		{"flags", data.Flags, rules.caa, false},
+		{"tag", data.Tag, rules.caa, rules.caa},
+		{"pullzone_id", data.PullzoneId, rules.pullzone, rules.pullzone},
+		{"script_id", data.ScriptId, rules.script, rules.script},
+	} {
+		if !item.allowed && !item.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(item.name), "Validation Error", fmt.Sprintf("type %s does not take %s", recordType, item.name))
+		}
+		if item.required && item.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(item.name), "Validation Error", fmt.Sprintf("type %s needs %s", recordType, item.name))
+		}
+	}
+
+	if !data.Port.IsNull() && !data.Port.IsUnknown() && (data.Port.ValueInt64() < 1 || data.Port.ValueInt64() > 65535) {
+		resp.Diagnostics.AddAttributeError(path.Root("port"), "Validation Error", "port must be between 1 and 65535")
+	}
+	if !data.Flags.IsNull() && !data.Flags.IsUnknown() && (data.Flags.ValueInt64() < 0 || data.Flags.ValueInt64() > 255) {
+		resp.Diagnostics.AddAttributeError(path.Root("flags"), "Validation Error", "flags must be between 0 and 255")
+	}
+	if !data.Tag.IsNull() && !data.Tag.IsUnknown() && !dnsRecordCaaTags[data.Tag.ValueString()] {
+		resp.Diagnostics.AddAttributeError(path.Root("tag"), "Validation Error", "tag must be issue, issuewild or iodef")
+	}
+	if !data.Ttl.IsNull() && !data.Ttl.IsUnknown() && data.Ttl.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("ttl"), "Validation Error", "ttl must be at least 1")
+	}
+
+	if !data.MonitorType.IsNull() && !data.MonitorType.IsUnknown() {
+		monitorType := data.MonitorType.ValueString()
+		if _, ok := bunnycdn_api.DnsRecordMonitorTypes[monitorType]; !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("monitor_type"), "Validation Error",
+				fmt.Sprintf("unknown monitor_type %s, expected one of %s", monitorType, typeNames(bunnycdn_api.DnsRecordMonitorTypes)))
+		} else if monitorType != "none" && !rules.monitor {
+			resp.Diagnostics.AddAttributeError(path.Root("monitor_type"), "Validation Error", fmt.Sprintf("records of type %s can not be monitored", recordType))
+		}
+	}
+
+	if data.SmartRoutingType.IsUnknown() {
+		return
+	}
+	smartRoutingType := "none"
+	if !data.SmartRoutingType.IsNull() {
+		smartRoutingType = data.SmartRoutingType.ValueString()
+	}
+	if _, ok := bunnycdn_api.DnsRecordSmartRoutingTypes[smartRoutingType]; !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("smart_routing_type"), "Validation Error",
+			fmt.Sprintf("unknown smart_routing_type %s, expected one of %s", smartRoutingType, typeNames(bunnycdn_api.DnsRecordSmartRoutingTypes)))
+		return
+	}
+	if smartRoutingType != "latency" && !data.LatencyZone.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("latency_zone"), "Validation Error", "latency_zone needs smart_routing_type latency")
+	}
+	for _, item := range []struct {
+		name  string
+		value types.Float64
+		limit float64
+	}{
+		{"geolocation_latitude", data.GeolocationLatitude, 90},
+		{"geolocation_longitude", data.GeolocationLongitude, 180},
+	} {
+		if smartRoutingType != "geolocation" {
+			if !item.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(item.name), "Validation Error", fmt.Sprintf("%s needs smart_routing_type geolocation", item.name))
+			}
+			continue
+		}
+		if item.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(item.name), "Validation Error", fmt.Sprintf("smart_routing_type geolocation needs %s", item.name))
+		} else if !item.value.IsUnknown() && (item.value.ValueFloat64() < -item.limit || item.value.ValueFloat64() > item.limit) {
+			resp.Diagnostics.AddAttributeError(path.Root(item.name), "Validation Error", fmt.Sprintf("%s must be between %g and %g", item.name, -item.limit, item.limit))
+		}
+	}
+}
+
+func (r *DnsRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.DnsRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdResource, err := r.api.DnsRecordCreate(ctx, data.ZoneId.ValueInt64(), bunnycdn_api.DnsRecordResourceModelToDnsRecord(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS record, got error: %s", err))
+		return
+	}
+
+	data = dnsRecordState(data, createdResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.DnsRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteResource, err := r.api.DnsRecordGet(ctx, data.ZoneId.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		dnsRecordError, ok := err.(*model.DnsRecordError)
+		if ok && dnsRecordError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		dnsZoneError, ok := err.(*model.DnsZoneError)
+		if ok && dnsZoneError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS record, got error: %s", err))
+		return
+	}
+
+	data = dnsRecordState(data, remoteResource)
+
+	// imported PullZone records have no pull zone yet, it is found by name
+	if data.Type.ValueString() == "PullZone" && data.PullzoneId.IsNull() && remoteResource.LinkName != "" {
+		pullzones, err := r.api.PullzoneList(ctx, remoteResource.LinkName)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find pull zone %s, got error: %s", remoteResource.LinkName, err))
+			return
+		}
+		for _, item := range pullzones {
+			if item.Name == remoteResource.LinkName {
+				data.PullzoneId = types.Int64Value(item.Id)
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data model.DnsRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.DnsRecordUpdate(ctx, data.ZoneId.ValueInt64(), bunnycdn_api.DnsRecordResourceModelToDnsRecord(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS record, got error: %s", err))
+		return
+	}
+
+	// bunny.net does not return the updated record
+	updatedResource, err := r.api.DnsRecordGet(ctx, data.ZoneId.ValueInt64(), data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS record, got error: %s", err))
+		return
+	}
+
+	data = dnsRecordState(data, updatedResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.DnsRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.DnsRecordDelete(ctx, data.ZoneId.ValueInt64(), bunnycdn_api.DnsRecordResourceModelToDnsRecord(data))
+	if err != nil {
+		dnsRecordError, ok := err.(*model.DnsRecordError)
+		if ok && dnsRecordError.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS record, got error: %s", err))
+		return
+	}
+}
+
+func (r *DnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected import ID in the form <zone_id>/<record_id>, got: %s", req.ID))
+		return
+	}
+
+	zoneId, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid DNS zone ID %s", parts[0]))
+		return
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Invalid DNS record ID %s", parts[1]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), zoneId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// dnsRecordState returns the state of the record. bunny.net does not return
+// the ID of the pull zone or edge script a record points to, so they are kept
+// from data.
+func dnsRecordState(data model.DnsRecordResourceModel, resource *bunnycdn_api.DnsRecord) model.DnsRecordResourceModel {
+	state := bunnycdn_api.DnsRecordToDnsRecordResourceModel(data.ZoneId.ValueInt64(), resource)
+	if state.PullzoneId.IsNull() {
+		state.PullzoneId = data.PullzoneId
+	}
+	if state.ScriptId.IsNull() {
+		state.ScriptId = data.ScriptId
+	}
+	return state
+}
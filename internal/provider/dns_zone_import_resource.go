@@ -0,0 +1,464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+	"terraform-provider-bunnycdn/internal/zonefile"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &DnsZoneImportResource{}
+var _ resource.ResourceWithModifyPlan = &DnsZoneImportResource{}
+
+func NewDnsZoneImportResource() resource.Resource {
+	return &DnsZoneImportResource{}
+}
+
+type DnsZoneImportResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (r *DnsZoneImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_import"
+}
+
+func (r *DnsZoneImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates the records of an RFC 1035 zone file in a Bunny DNS zone. Records removed from the zone file are deleted, constructs Bunny DNS does not support are skipped and listed in `unsupported`",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the DNS zone to create the records in. Changing it creates the records in the new DNS zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The content of the zone file, e.g. `file(\"example.com.zone\")`. Relative names are relative to the domain of the DNS zone unless the file sets `$ORIGIN`",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"default_ttl": schema.Int64Attribute{
+				MarkdownDescription: "The TTL of records without one when the zone file has no `$TTL` directive",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3600),
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"records": schema.MapAttribute{
+				MarkdownDescription: "The IDs of the created records by name, type and value, e.g. `www A 192.0.2.1`",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Map{},
+			},
+			"record_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of records in the zone file",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"unsupported": schema.ListAttribute{
+				MarkdownDescription: "The constructs of the zone file that were skipped, with their line and the reason",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.List{},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the DNS zone",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DnsZoneImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+// parse parses the zone file of data for the domain of its DNS zone, which
+// is returned as well.
+func (r *DnsZoneImportResource) parse(ctx context.Context, data model.DnsZoneImportResourceModel) (*zonefile.Zone, *bunnycdn_api.DnsZone, error) {
+	dnsZone, err := r.api.DnsZoneGet(ctx, data.ZoneId.ValueInt64())
+	if err != nil {
+		return nil, nil, err
+	}
+	zone, err := zonefile.Parse(data.Content.ValueString(), dnsZone.Domain, data.DefaultTtl.ValueInt64())
+	return zone, dnsZone, err
+}
+
+func (r *DnsZoneImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, state model.DnsZoneImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ZoneId.IsUnknown() || data.Content.IsUnknown() || data.DefaultTtl.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("records"), types.MapUnknown(types.Int64Type))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("record_count"), types.Int64Unknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unsupported"), types.ListUnknown(types.StringType))...)
+		return
+	}
+
+	zone, _, err := r.parse(ctx, data)
+	if err != nil {
+		if _, ok := err.(*model.DnsZoneError); ok {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS zone, got error: %s", err))
+			return
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Validation Error", fmt.Sprintf("Invalid zone file: %s", err))
+		return
+	}
+
+	if len(zone.Unsupported) > 0 {
+		resp.Diagnostics.AddAttributeWarning(path.Root("content"), "Unsupported zone file constructs",
+			fmt.Sprintf("The following constructs of the zone file are skipped:\n%s", strings.Join(dnsZoneImportUnsupported(zone), "\n")))
+	}
+
+	// records that are created by this apply are only known after it
+	previous := dnsZoneImportRecordIds(state)
+	records := map[string]attr.Value{}
+	for _, item := range zone.Records {
+		if id, ok := previous[item.Key()]; ok {
+			records[item.Key()] = types.Int64Value(id)
+		} else {
+			records[item.Key()] = types.Int64Unknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("records"), types.MapValueMust(types.Int64Type, records))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("record_count"), int64(len(zone.Records)))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unsupported"), dnsZoneImportUnsupportedList(zone))...)
+}
+
+func dnsZoneImportUnsupported(zone *zonefile.Zone) []string {
+	items := []string{}
+	for _, item := range zone.Unsupported {
+		items = append(items, item.String())
+	}
+	return items
+}
+
+func dnsZoneImportUnsupportedList(zone *zonefile.Zone) types.List {
+	elements := []attr.Value{}
+	for _, item := range dnsZoneImportUnsupported(zone) {
+		elements = append(elements, types.StringValue(item))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// dnsZoneImportRecordIds returns the IDs of the created records by record.
+func dnsZoneImportRecordIds(data model.DnsZoneImportResourceModel) map[string]int64 {
+	recordIds := map[string]int64{}
+	for key, item := range data.Records.Elements() {
+		if item, ok := item.(types.Int64); ok && !item.IsUnknown() && !item.IsNull() {
+			recordIds[key] = item.ValueInt64()
+		}
+	}
+	return recordIds
+}
+
+func setDnsZoneImportRecordIds(data *model.DnsZoneImportResourceModel, recordIds map[string]int64) {
+	elements := map[string]attr.Value{}
+	for key, item := range recordIds {
+		elements[key] = types.Int64Value(item)
+	}
+	data.Records = types.MapValueMust(types.Int64Type, elements)
+	data.Id = types.StringValue(strconv.FormatInt(data.ZoneId.ValueInt64(), 10))
+}
+
+// dnsZoneImportRecord converts a record of a zone file to a Bunny DNS record.
+func dnsZoneImportRecord(record zonefile.Record) bunnycdn_api.DnsRecord {
+	dnsRecord := bunnycdn_api.DnsRecord{
+		Type:  bunnycdn_api.DnsRecordTypes[record.Type],
+		Ttl:   record.Ttl,
+		Name:  record.Name,
+		Value: record.Value,
+	}
+	switch record.Type {
+	case "MX":
+		dnsRecord.Priority = &record.Priority
+	case "SRV":
+		dnsRecord.Priority = &record.Priority
+		dnsRecord.Weight = &record.Weight
+		dnsRecord.Port = &record.Port
+	case "CAA":
+		dnsRecord.Flags = &record.Flags
+		dnsRecord.Tag = &record.Tag
+	}
+	return dnsRecord
+}
+
+// sameDnsZoneImportRecord reports whether the Bunny DNS records a and b have
+// the same name, type and value, and only differ in their settings.
+func sameDnsZoneImportRecord(a bunnycdn_api.DnsRecord, b bunnycdn_api.DnsRecord) bool {
+	return strings.EqualFold(a.Name, b.Name) && a.Type == b.Type && a.Value == b.Value &&
+		stringOrEmpty(a.Tag) == stringOrEmpty(b.Tag)
+}
+
+// dnsZoneImportRecordChanged reports whether the settings of remote differ
+// from those of record.
+func dnsZoneImportRecordChanged(record bunnycdn_api.DnsRecord, remote bunnycdn_api.DnsRecord) bool {
+	return record.Ttl != remote.Ttl || int64OrZero(record.Priority) != int64OrZero(remote.Priority) ||
+		int64OrZero(record.Weight) != int64OrZero(remote.Weight) || int64OrZero(record.Port) != int64OrZero(remote.Port) ||
+		int64OrZero(record.Flags) != int64OrZero(remote.Flags)
+}
+
+func int64OrZero(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// apply makes the records of the DNS zone match the zone file of data
+// without taking records offline. previous holds the IDs of the records
+// created before. Records keep their ID as long as their name, type and value
+// stay the same, and are updated in place when their TTL or other settings
+// change. New records are created before removed ones are deleted, except
+// where a CNAME record takes or leaves a name, as it can not share it with
+// other records. Records that fail are left out of the state, so the next
+// apply retries them.
+func (r *DnsZoneImportResource) apply(ctx context.Context, data *model.DnsZoneImportResourceModel, previous map[string]int64, diagnostics *diag.Diagnostics) {
+	zoneId := data.ZoneId.ValueInt64()
+
+	zone, dnsZone, err := r.parse(ctx, *data)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse zone file, got error: %s", err))
+		return
+	}
+
+	// the previous records that still exist, records deleted outside of
+	// terraform are created again
+	tracked := map[int64]bunnycdn_api.DnsRecord{}
+	previousIds := map[int64]bool{}
+	for _, id := range previous {
+		previousIds[id] = true
+	}
+	for _, item := range dnsZone.Records {
+		if previousIds[item.Id] {
+			tracked[item.Id] = item
+		}
+	}
+
+	recordIds := map[string]int64{}
+	created := []zonefile.Record{}
+	for _, item := range zone.Records {
+		record := dnsZoneImportRecord(item)
+		remote, ok := tracked[previous[item.Key()]]
+		if !ok || !sameDnsZoneImportRecord(record, remote) {
+			ok = false
+			for _, candidate := range tracked {
+				if sameDnsZoneImportRecord(record, candidate) {
+					remote, ok = candidate, true
+					break
+				}
+			}
+		}
+		if !ok {
+			created = append(created, item)
+			continue
+		}
+		delete(tracked, remote.Id)
+		recordIds[item.Key()] = remote.Id
+
+		if dnsZoneImportRecordChanged(record, remote) {
+			record.Id = remote.Id
+			err := r.api.DnsRecordUpdate(ctx, zoneId, record)
+			if err != nil {
+				diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS record %s from line %d, got error: %s", item.Key(), item.Line, err))
+			}
+		}
+	}
+
+	// the records left in tracked are removed from the zone file
+	deleteRecord := func(remote bunnycdn_api.DnsRecord) {
+		delete(tracked, remote.Id)
+		err := r.api.DnsRecordDelete(ctx, zoneId, remote)
+		if dnsRecordError, ok := err.(*model.DnsRecordError); ok && dnsRecordError.StatusCode == 404 {
+			err = nil
+		}
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS record %d %s, got error: %s", remote.Id, remote.Name, err))
+			for key, id := range previous {
+				if id == remote.Id {
+					recordIds[key] = id
+				}
+			}
+		}
+	}
+
+	cname := bunnycdn_api.DnsRecordTypes["CNAME"]
+	for _, item := range created {
+		record := dnsZoneImportRecord(item)
+		for _, remote := range tracked {
+			if strings.EqualFold(remote.Name, record.Name) && (remote.Type == cname || record.Type == cname) {
+				deleteRecord(remote)
+			}
+		}
+
+		createdRecord, err := r.api.DnsRecordCreate(ctx, zoneId, record)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS record %s from line %d, got error: %s", item.Key(), item.Line, err))
+			continue
+		}
+		recordIds[item.Key()] = createdRecord.Id
+	}
+
+	for _, remote := range tracked {
+		deleteRecord(remote)
+	}
+
+	data.RecordCount = types.Int64Value(int64(len(zone.Records)))
+	data.Unsupported = dnsZoneImportUnsupportedList(zone)
+	setDnsZoneImportRecordIds(data, recordIds)
+}
+
+func (r *DnsZoneImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.DnsZoneImportResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, nil, &resp.Diagnostics)
+	if data.Records.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsZoneImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.DnsZoneImportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dnsZone, err := r.api.DnsZoneGet(ctx, data.ZoneId.ValueInt64())
+	if err != nil {
+		dnsZoneError, ok := err.(*model.DnsZoneError)
+		if ok && dnsZoneError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS zone, got error: %s", err))
+		return
+	}
+	existing := map[int64]bool{}
+	for _, item := range dnsZone.Records {
+		existing[item.Id] = true
+	}
+
+	// records deleted outside of terraform are created again on the next apply
+	recordIds := map[string]int64{}
+	for key, id := range dnsZoneImportRecordIds(data) {
+		if existing[id] {
+			recordIds[key] = id
+		}
+	}
+
+	setDnsZoneImportRecordIds(&data, recordIds)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsZoneImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state model.DnsZoneImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, dnsZoneImportRecordIds(state), &resp.Diagnostics)
+	if data.Records.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsZoneImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.DnsZoneImportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for key, id := range dnsZoneImportRecordIds(data) {
+		err := r.api.DnsRecordDelete(ctx, data.ZoneId.ValueInt64(), bunnycdn_api.DnsRecord{Id: id, Name: key})
+		if err != nil {
+			dnsRecordError, ok := err.(*model.DnsRecordError)
+			if ok && dnsRecordError.StatusCode == 404 {
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS record %s, got error: %s", key, err))
+		}
+	}
+}
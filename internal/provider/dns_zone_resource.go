@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &DnsZoneResource{}
+var _ resource.ResourceWithImportState = &DnsZoneResource{}
+var _ resource.ResourceWithValidateConfig = &DnsZoneResource{}
+var _ resource.ResourceWithModifyPlan = &DnsZoneResource{}
+
+func NewDnsZoneResource() resource.Resource {
+	return &DnsZoneResource{}
+}
+
+type DnsZoneResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (r *DnsZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (r *DnsZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Bunny DNS zone resource",
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain of the DNS zone. Changing it creates a new DNS zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_nameservers_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the DNS zone uses `nameserver_1` and `nameserver_2` instead of the bunny.net nameservers",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers:       []planmodifier.Bool{},
+			},
+			"nameserver_1": schema.StringAttribute{
+				MarkdownDescription: "The first nameserver of the DNS zone. Can only be set when `custom_nameservers_enabled` is true",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nameserver_2": schema.StringAttribute{
+				MarkdownDescription: "The second nameserver of the DNS zone. Can only be set when `custom_nameservers_enabled` is true",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"soa_email": schema.StringAttribute{
+				MarkdownDescription: "The email address in the SOA record of the DNS zone",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"logging_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether DNS query logging is enabled",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers:       []planmodifier.Bool{},
+			},
+			"logging_ip_anonymization_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the IP addresses in the DNS query logs are anonymized",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers:       []planmodifier.Bool{},
+			},
+			"log_anonymization_type": schema.StringAttribute{
+				MarkdownDescription: "How IP addresses are anonymized, `one_digit` removes the last octet and `drop` removes the whole address",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("one_digit"),
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"nameservers": schema.ListAttribute{
+				MarkdownDescription: "The nameservers to configure at the registrar of the domain",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nameservers_detected": schema.BoolAttribute{
+				MarkdownDescription: "Whether bunny.net detected that the domain uses the nameservers of the DNS zone",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the DNS zone",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DnsZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *DnsZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.DnsZoneResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CustomNameserversEnabled.IsUnknown() {
+		custom := data.CustomNameserversEnabled.ValueBool()
+		for _, item := range []struct {
+			name  string
+			value types.String
+		}{{"nameserver_1", data.Nameserver1}, {"nameserver_2", data.Nameserver2}} {
+			if custom && item.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(item.name), "Validation Error", fmt.Sprintf("%s must be set when custom_nameservers_enabled is true", item.name))
+			}
+			if !custom && !item.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(item.name), "Validation Error", fmt.Sprintf("%s can only be set when custom_nameservers_enabled is true", item.name))
+			}
+		}
+	}
+
+	if !data.LogAnonymizationType.IsNull() && !data.LogAnonymizationType.IsUnknown() {
+		if _, ok := bunnycdn_api.DnsZoneLogAnonymizationTypes[data.LogAnonymizationType.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("log_anonymization_type"), "Validation Error", "log_anonymization_type must be one_digit or drop")
+		}
+	}
+}
+
+func (r *DnsZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on create and destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var data, state model.DnsZoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the nameservers fall back to the bunny.net ones, which are only known after apply
+	if state.CustomNameserversEnabled.ValueBool() && !data.CustomNameserversEnabled.ValueBool() {
+		data.Nameserver1 = types.StringUnknown()
+		data.Nameserver2 = types.StringUnknown()
+	}
+
+	nameservers := types.ListUnknown(types.StringType)
+	if !data.Nameserver1.IsUnknown() && !data.Nameserver2.IsUnknown() {
+		nameservers = types.ListValueMust(types.StringType, []attr.Value{data.Nameserver1, data.Nameserver2})
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("nameserver_1"), data.Nameserver1)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("nameserver_2"), data.Nameserver2)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("nameservers"), nameservers)...)
+}
+
+func (r *DnsZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.DnsZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dnsZone := bunnycdn_api.DnsZoneResourceModelToDnsZone(data)
+	createdResource, err := r.api.DnsZoneCreate(ctx, dnsZone)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS zone, got error: %s", err))
+		return
+	}
+
+	// the settings of the DNS zone can only be set by an update
+	dnsZone.Id = createdResource.Id
+	updatedResource, err := r.api.DnsZoneUpdate(ctx, dnsZone)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS zone, got error: %s", err))
+		data = bunnycdn_api.DnsZoneToDnsZoneResourceModel(createdResource)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	data = bunnycdn_api.DnsZoneToDnsZoneResourceModel(updatedResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.DnsZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteResource, err := r.api.DnsZoneGet(ctx, data.Id.ValueInt64())
+	if err != nil {
+		dnsZoneError, ok := err.(*model.DnsZoneError)
+		if ok && dnsZoneError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS zone, got error: %s", err))
+		return
+	}
+
+	data = bunnycdn_api.DnsZoneToDnsZoneResourceModel(remoteResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data model.DnsZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedResource, err := r.api.DnsZoneUpdate(ctx, bunnycdn_api.DnsZoneResourceModelToDnsZone(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS zone, got error: %s", err))
+		return
+	}
+
+	data = bunnycdn_api.DnsZoneToDnsZoneResourceModel(updatedResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DnsZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.DnsZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.DnsZoneDelete(ctx, bunnycdn_api.DnsZoneResourceModelToDnsZone(data))
+	if err != nil {
+		dnsZoneError, ok := err.(*model.DnsZoneError)
+		if ok && dnsZoneError.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS zone, got error: %s", err))
+		return
+	}
+}
+
+func (r *DnsZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		domain := strings.TrimSuffix(strings.ToLower(req.ID), ".")
+		dnsZones, err := r.api.DnsZoneList(ctx, domain)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list DNS zones, got error: %s", err))
+			return
+		}
+
+		found := false
+		for _, item := range dnsZones {
+			if strings.EqualFold(item.Domain, domain) {
+				id = item.Id
+				found = true
+				break
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected a DNS zone ID or domain, no DNS zone found for: %s", req.ID))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
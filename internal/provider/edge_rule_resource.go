@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &EdgeRuleResource{}
+var _ resource.ResourceWithImportState = &EdgeRuleResource{}
+var _ resource.ResourceWithValidateConfig = &EdgeRuleResource{}
+
+func NewEdgeRuleResource() resource.Resource {
+	return &EdgeRuleResource{}
+}
+
+type EdgeRuleResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (r *EdgeRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_rule"
+}
+
+func (r *EdgeRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := edgeRuleAttributes()
+	attributes["pullzone_id"] = schema.Int64Attribute{
+		MarkdownDescription: "The ID of the pull zone",
+		Required:            true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+	attributes["id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The GUID of the edge rule",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Edge rule of a pull zone. The parameters each action and trigger type needs are checked at plan time.",
+
+		Attributes: attributes,
+	}
+}
+
+// edgeRuleAttributes returns the attributes of an edge rule, shared by the
+// edge rule resources.
+func edgeRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"action_type": schema.StringAttribute{
+			MarkdownDescription: "The action of the edge rule, one of " + typeNames(bunnycdn_api.EdgeRuleActionTypes),
+			Required:            true,
+			PlanModifiers:       []planmodifier.String{},
+		},
+		"action_parameter_1": schema.StringAttribute{
+			MarkdownDescription: "The first parameter of the action: the URL for `redirect` and `origin_url`, the header name for " +
+				"`set_response_header` and `set_request_header`, the seconds for the cache time actions, the status code for " +
+				"`set_status_code`, the limit for the limit actions and the storage zone ID for `origin_storage`",
+			Optional:      true,
+			PlanModifiers: []planmodifier.String{},
+		},
+		"action_parameter_2": schema.StringAttribute{
+			MarkdownDescription: "The second parameter of the action: the 3xx status code for `redirect` and the header value for " +
+				"`set_response_header` and `set_request_header`",
+			Optional:      true,
+			PlanModifiers: []planmodifier.String{},
+		},
+		"triggers": schema.ListNestedAttribute{
+			MarkdownDescription: "The triggers of the edge rule",
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the trigger, one of " + typeNames(bunnycdn_api.EdgeRuleTriggerTypes),
+						Required:            true,
+						PlanModifiers:       []planmodifier.String{},
+					},
+					"pattern_matches": schema.ListAttribute{
+						MarkdownDescription: "The patterns the trigger matches, `*` is a wildcard",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+					"pattern_matching_type": schema.Int64Attribute{
+						MarkdownDescription: "How the patterns are matched (0 = MatchAny, 1 = MatchAll, 2 = MatchNone)",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						PlanModifiers:       []planmodifier.Int64{},
+					},
+					"parameter_1": schema.StringAttribute{
+						MarkdownDescription: "The header name for `request_header` and `response_header` and the cookie name for `cookie_value`",
+						Optional:            true,
+						PlanModifiers:       []planmodifier.String{},
+					},
+				},
+			},
+		},
+		"trigger_matching_type": schema.Int64Attribute{
+			MarkdownDescription: "How the triggers are matched (0 = MatchAny, 1 = MatchAll, 2 = MatchNone)",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+			PlanModifiers:       []planmodifier.Int64{},
+		},
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the edge rule is enabled",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+			PlanModifiers:       []planmodifier.Bool{},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the edge rule",
+			Optional:            true,
+			PlanModifiers:       []planmodifier.String{},
+		},
+	}
+}
+
+func (r *EdgeRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *EdgeRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.EdgeRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateEdgeRule(data, path.Empty(), &resp.Diagnostics)
+}
+
+func (r *EdgeRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.EdgeRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	edgeRule := bunnycdn_api.EdgeRuleResourceModelToEdgeRule(data)
+	edgeRule.Guid = ""
+	createdResource, err := r.api.EdgeRuleAddOrUpdate(ctx, data.PullzoneId.ValueInt64(), edgeRule)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create edge rule, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(createdResource.Guid)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EdgeRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.EdgeRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteResource, err := r.api.EdgeRuleGet(ctx, data.PullzoneId.ValueInt64(), data.Id.ValueString())
+	if err != nil {
+		edgeRuleError, ok := err.(*model.EdgeRuleError)
+		if ok && edgeRuleError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		pullzoneError, ok := err.(*model.PullzoneError)
+		if ok && pullzoneError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read edge rule, got error: %s", err))
+		return
+	}
+
+	data = bunnycdn_api.EdgeRuleToEdgeRuleResourceModel(data.PullzoneId.ValueInt64(), remoteResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EdgeRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data model.EdgeRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.api.EdgeRuleAddOrUpdate(ctx, data.PullzoneId.ValueInt64(), bunnycdn_api.EdgeRuleResourceModelToEdgeRule(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update edge rule, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EdgeRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.EdgeRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.EdgeRuleDelete(ctx, data.PullzoneId.ValueInt64(), data.Id.ValueString())
+	if err != nil {
+		edgeRuleError, ok := err.(*model.EdgeRuleError)
+		if ok && edgeRuleError.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete edge rule, got error: %s", err))
+		return
+	}
+}
+
+func (r *EdgeRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[1] == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected <pullzone_id>/<guid>, got: %s", req.ID))
+		return
+	}
+
+	pullzoneId, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected a pull zone ID, got: %s", parts[0]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pullzone_id"), pullzoneId)...)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// edgeRuleActionParameters lists the parameters each action takes. Actions
+// that are not listed take no parameters.
+var edgeRuleActionParameters = map[string][2]valueCheck{
+	"redirect":                      {validateUrl, validateIntegerBetween(300, 399)},
+	"origin_url":                    {validateUrl, nil},
+	"override_cache_time":           {validateIntegerBetween(0, 1<<31-1), nil},
+	"override_cache_time_public":    {validateIntegerBetween(0, 1<<31-1), nil},
+	"override_browser_cache_time":   {validateIntegerBetween(0, 1<<31-1), nil},
+	"set_response_header":           {validateNotEmpty, validateAny},
+	"set_request_header":            {validateNotEmpty, validateAny},
+	"set_status_code":               {validateIntegerBetween(100, 599), nil},
+	"origin_storage":                {validateIntegerBetween(1, 1<<62), nil},
+	"set_network_rate_limit":        {validateIntegerBetween(1, 1<<31-1), nil},
+	"set_connection_limit":          {validateIntegerBetween(1, 1<<31-1), nil},
+	"set_requests_per_second_limit": {validateIntegerBetween(1, 1<<31-1), nil},
+}
+
+// edgeRuleTriggerParameters lists the triggers that need parameter_1.
+var edgeRuleTriggerParameters = map[string]bool{
+	"request_header":  true,
+	"response_header": true,
+	"cookie_value":    true,
+}
+
+// edgeRuleNamedCode returns the name of value when it is a numeric code that
+// has a name. Such codes are rejected, as they would be read back as the name.
+func edgeRuleNamedCode(names map[string]int64, value string) (string, bool) {
+	if _, named := names[value]; named {
+		return "", false
+	}
+	code, ok := bunnycdn_api.TypeCode(names, value)
+	if !ok {
+		return "", false
+	}
+	for name, item := range names {
+		if item == code {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// validateEdgeRule checks that the action and triggers of data are known and
+// get the parameters they need. base is the path of the edge rule.
+func validateEdgeRule(data model.EdgeRuleResourceModel, base path.Path, diagnostics *diag.Diagnostics) {
+	if !data.ActionType.IsUnknown() {
+		actionType := data.ActionType.ValueString()
+		if _, ok := bunnycdn_api.TypeCode(bunnycdn_api.EdgeRuleActionTypes, actionType); !ok {
+			diagnostics.AddAttributeError(base.AtName("action_type"), "Validation Error",
+				fmt.Sprintf("unknown action_type %s, expected one of %s", actionType, typeNames(bunnycdn_api.EdgeRuleActionTypes)))
+		} else if name, ok := edgeRuleNamedCode(bunnycdn_api.EdgeRuleActionTypes, actionType); ok {
+			diagnostics.AddAttributeError(base.AtName("action_type"), "Validation Error",
+				fmt.Sprintf("action_type %s has a name, use %s instead", actionType, name))
+		} else if _, named := bunnycdn_api.EdgeRuleActionTypes[actionType]; named {
+			parameters := edgeRuleActionParameters[actionType]
+			for i, value := range []types.String{data.ActionParameter1, data.ActionParameter2} {
+				name := fmt.Sprintf("action_parameter_%d", i+1)
+				validateEdgeRuleParameter(actionType, name, value, parameters[i], base.AtName(name), diagnostics)
+			}
+		}
+	}
+
+	if len(data.Triggers) == 0 {
+		diagnostics.AddAttributeError(base.AtName("triggers"), "Validation Error", "an edge rule needs at least one trigger")
+	}
+	if len(data.Triggers) > bunnycdn_api.EdgeRuleMaxTriggers {
+		diagnostics.AddAttributeError(base.AtName("triggers"), "Validation Error",
+			fmt.Sprintf("an edge rule can have at most %d triggers", bunnycdn_api.EdgeRuleMaxTriggers))
+	}
+	for i, trigger := range data.Triggers {
+		triggerPath := base.AtName("triggers").AtListIndex(i)
+		if trigger.Type.IsUnknown() {
+			continue
+		}
+
+		triggerType := trigger.Type.ValueString()
+		if _, ok := bunnycdn_api.TypeCode(bunnycdn_api.EdgeRuleTriggerTypes, triggerType); !ok {
+			diagnostics.AddAttributeError(triggerPath.AtName("type"), "Validation Error",
+				fmt.Sprintf("unknown trigger type %s, expected one of %s", triggerType, typeNames(bunnycdn_api.EdgeRuleTriggerTypes)))
+			continue
+		}
+		if name, ok := edgeRuleNamedCode(bunnycdn_api.EdgeRuleTriggerTypes, triggerType); ok {
+			diagnostics.AddAttributeError(triggerPath.AtName("type"), "Validation Error",
+				fmt.Sprintf("trigger type %s has a name, use %s instead", triggerType, name))
+			continue
+		}
+		if trigger.PatternMatches != nil && len(trigger.PatternMatches) == 0 {
+			diagnostics.AddAttributeError(triggerPath.AtName("pattern_matches"), "Validation Error", "a trigger needs at least one pattern")
+		}
+		if len(trigger.PatternMatches) > bunnycdn_api.EdgeRuleMaxPatternMatches {
+			diagnostics.AddAttributeError(triggerPath.AtName("pattern_matches"), "Validation Error",
+				fmt.Sprintf("a trigger can have at most %d patterns", bunnycdn_api.EdgeRuleMaxPatternMatches))
+		}
+		if edgeRuleTriggerParameters[triggerType] && trigger.Parameter1.IsNull() {
+			diagnostics.AddAttributeError(triggerPath.AtName("parameter_1"), "Validation Error",
+				fmt.Sprintf("trigger type %s needs parameter_1", triggerType))
+		}
+	}
+}
+
+func validateEdgeRuleParameter(actionType string, name string, value types.String, check valueCheck, attributePath path.Path, diagnostics *diag.Diagnostics) {
+	if value.IsUnknown() {
+		return
+	}
+	if check == nil {
+		if !value.IsNull() {
+			diagnostics.AddAttributeError(attributePath, "Validation Error", fmt.Sprintf("action_type %s does not take %s", actionType, name))
+		}
+		return
+	}
+	if value.IsNull() {
+		diagnostics.AddAttributeError(attributePath, "Validation Error", fmt.Sprintf("action_type %s needs %s", actionType, name))
+		return
+	}
+	if message := check(value.ValueString()); message != "" {
+		diagnostics.AddAttributeError(attributePath, "Validation Error", fmt.Sprintf("%s of action_type %s %s", name, actionType, message))
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEdgeRuleTypeMapping(t *testing.T) {
+	for _, names := range []map[string]int64{bunnycdn_api.EdgeRuleActionTypes, bunnycdn_api.EdgeRuleTriggerTypes} {
+		codes := map[int64]string{}
+		for name, code := range names {
+			if other, ok := codes[code]; ok {
+				t.Errorf("%s and %s have the same code %d", name, other, code)
+			}
+			codes[code] = name
+		}
+	}
+
+	for name, code := range bunnycdn_api.EdgeRuleActionTypes {
+		edgeRule := bunnycdn_api.EdgeRuleToEdgeRuleResourceModel(1, &bunnycdn_api.EdgeRule{ActionType: code})
+		if edgeRule.ActionType.ValueString() != name {
+			t.Errorf("action type %d read as %s, expected %s", code, edgeRule.ActionType.ValueString(), name)
+		}
+		if converted := bunnycdn_api.EdgeRuleResourceModelToEdgeRule(edgeRule); converted.ActionType != code {
+			t.Errorf("action type %s written as %d, expected %d", name, converted.ActionType, code)
+		}
+	}
+
+	for name, code := range bunnycdn_api.EdgeRuleTriggerTypes {
+		edgeRule := bunnycdn_api.EdgeRuleToEdgeRuleResourceModel(1, &bunnycdn_api.EdgeRule{Triggers: []bunnycdn_api.EdgeRuleTrigger{{Type: code}}})
+		if edgeRule.Triggers[0].Type.ValueString() != name {
+			t.Errorf("trigger type %d read as %s, expected %s", code, edgeRule.Triggers[0].Type.ValueString(), name)
+		}
+		if converted := bunnycdn_api.EdgeRuleResourceModelToEdgeRule(edgeRule); converted.Triggers[0].Type != code {
+			t.Errorf("trigger type %s written as %d, expected %d", name, converted.Triggers[0].Type, code)
+		}
+	}
+
+	// codes without a name are kept as numbers
+	edgeRule := bunnycdn_api.EdgeRuleToEdgeRuleResourceModel(1, &bunnycdn_api.EdgeRule{
+		ActionType: 99,
+		Triggers:   []bunnycdn_api.EdgeRuleTrigger{{Type: 98}},
+	})
+	if edgeRule.ActionType.ValueString() != "99" || edgeRule.Triggers[0].Type.ValueString() != "98" {
+		t.Errorf("unnamed codes read as %s and %s", edgeRule.ActionType.ValueString(), edgeRule.Triggers[0].Type.ValueString())
+	}
+	converted := bunnycdn_api.EdgeRuleResourceModelToEdgeRule(edgeRule)
+	if converted.ActionType != 99 || converted.Triggers[0].Type != 98 {
+		t.Errorf("unnamed codes written as %d and %d", converted.ActionType, converted.Triggers[0].Type)
+	}
+}
+
+func edgeRuleTrigger(triggerType string, patterns int) model.EdgeRuleTriggerModel {
+	patternMatches := []types.String{}
+	for i := 0; i < patterns; i++ {
+		patternMatches = append(patternMatches, types.StringValue(fmt.Sprintf("*/path/%d", i)))
+	}
+	return model.EdgeRuleTriggerModel{
+		Type:                types.StringValue(triggerType),
+		PatternMatches:      patternMatches,
+		PatternMatchingType: types.Int64Value(0),
+		Parameter1:          types.StringNull(),
+	}
+}
+
+func TestValidateEdgeRule(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(data *model.EdgeRuleResourceModel)
+		errors []string
+	}{
+		{
+			name:   "redirect",
+			modify: func(data *model.EdgeRuleResourceModel) {},
+		},
+		{
+			name: "redirect with variables",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionParameter1 = types.StringValue("https://example.com{{path}}")
+				data.ActionParameter2 = types.StringValue("399")
+			},
+		},
+		{
+			name: "redirect without URL",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionParameter1 = types.StringNull()
+			},
+			errors: []string{"action_type redirect needs action_parameter_1"},
+		},
+		{
+			name: "redirect to relative URL",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionParameter1 = types.StringValue("/new")
+			},
+			errors: []string{"action_parameter_1 of action_type redirect must be an absolute http or https URL"},
+		},
+		{
+			name: "redirect with status code 200",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionParameter2 = types.StringValue("200")
+			},
+			errors: []string{"action_parameter_2 of action_type redirect must be an integer between 300 and 399"},
+		},
+		{
+			name: "unknown parameter",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionParameter1 = types.StringUnknown()
+			},
+		},
+		{
+			name: "action without parameters",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionType = types.StringValue("force_ssl")
+				data.ActionParameter2 = types.StringNull()
+			},
+			errors: []string{"action_type force_ssl does not take action_parameter_1"},
+		},
+		{
+			name: "header action",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionType = types.StringValue("set_response_header")
+				data.ActionParameter1 = types.StringValue("")
+				data.ActionParameter2 = types.StringValue("")
+			},
+			errors: []string{"action_parameter_1 of action_type set_response_header must not be empty"},
+		},
+		{
+			name: "unknown action",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionType = types.StringValue("redirect_all")
+			},
+			errors: []string{"unknown action_type redirect_all, expected one of"},
+		},
+		{
+			name: "numeric action without name",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionType = types.StringValue("99")
+			},
+		},
+		{
+			name: "numeric action with name",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionType = types.StringValue("1")
+			},
+			errors: []string{"action_type 1 has a name, use redirect instead"},
+		},
+		{
+			name: "negative action",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.ActionType = types.StringValue("-1")
+			},
+			errors: []string{"unknown action_type -1"},
+		},
+		{
+			name: "no triggers",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.Triggers = nil
+			},
+			errors: []string{"an edge rule needs at least one trigger"},
+		},
+		{
+			name: "maximum triggers",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				for len(data.Triggers) < bunnycdn_api.EdgeRuleMaxTriggers {
+					data.Triggers = append(data.Triggers, edgeRuleTrigger("url", 1))
+				}
+			},
+		},
+		{
+			name: "too many triggers",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				for len(data.Triggers) <= bunnycdn_api.EdgeRuleMaxTriggers {
+					data.Triggers = append(data.Triggers, edgeRuleTrigger("url", 1))
+				}
+			},
+			errors: []string{"an edge rule can have at most 5 triggers"},
+		},
+		{
+			name: "maximum patterns",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.Triggers[0] = edgeRuleTrigger("url", bunnycdn_api.EdgeRuleMaxPatternMatches)
+			},
+		},
+		{
+			name: "too many patterns",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.Triggers[0] = edgeRuleTrigger("url", bunnycdn_api.EdgeRuleMaxPatternMatches+1)
+			},
+			errors: []string{"a trigger can have at most 20 patterns"},
+		},
+		{
+			name: "no patterns",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.Triggers[0] = edgeRuleTrigger("url", 0)
+			},
+			errors: []string{"a trigger needs at least one pattern"},
+		},
+		{
+			name: "unknown trigger",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.Triggers[0].Type = types.StringValue("path")
+			},
+			errors: []string{"unknown trigger type path, expected one of"},
+		},
+		{
+			name: "numeric trigger without name",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.Triggers[0].Type = types.StringValue("99")
+			},
+		},
+		{
+			name: "numeric trigger with name",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.Triggers[0].Type = types.StringValue("0")
+			},
+			errors: []string{"trigger type 0 has a name, use url instead"},
+		},
+		{
+			name: "trigger without parameter_1",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.Triggers[0].Type = types.StringValue("request_header")
+			},
+			errors: []string{"trigger type request_header needs parameter_1"},
+		},
+		{
+			name: "trigger with parameter_1",
+			modify: func(data *model.EdgeRuleResourceModel) {
+				data.Triggers[0].Type = types.StringValue("request_header")
+				data.Triggers[0].Parameter1 = types.StringValue("User-Agent")
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := model.EdgeRuleResourceModel{
+				ActionType:       types.StringValue("redirect"),
+				ActionParameter1: types.StringValue("https://example.com/new"),
+				ActionParameter2: types.StringValue("301"),
+				Triggers:         []model.EdgeRuleTriggerModel{edgeRuleTrigger("url", 1)},
+			}
+			test.modify(&data)
+
+			var diagnostics diag.Diagnostics
+			validateEdgeRule(data, path.Empty(), &diagnostics)
+
+			errors := []string{}
+			for _, item := range diagnostics.Errors() {
+				errors = append(errors, item.Detail())
+			}
+			if len(errors) != len(test.errors) {
+				t.Fatalf("expected errors %q, got %q", test.errors, errors)
+			}
+			for i := range errors {
+				if !strings.HasPrefix(errors[i], test.errors[i]) {
+					t.Errorf("expected error %q, got %q", test.errors[i], errors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestEdgeRuleNamedCode(t *testing.T) {
+	tests := []struct {
+		value string
+		name  string
+	}{
+		{value: "redirect", name: ""},
+		{value: "1", name: "redirect"},
+		{value: "20", name: "set_requests_per_second_limit"},
+		{value: "99", name: ""},
+		{value: "unknown", name: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			name, ok := edgeRuleNamedCode(bunnycdn_api.EdgeRuleActionTypes, test.value)
+			if name != test.name || ok != (test.name != "") {
+				t.Errorf("expected %q, got %q, %t", test.name, name, ok)
+			}
+		})
+	}
+}
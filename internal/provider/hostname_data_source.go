@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &HostnameDataSource{}
+
+func NewHostnameDataSource() datasource.DataSource {
+	return &HostnameDataSource{}
+}
+
+type HostnameDataSource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (d *HostnameDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hostname"
+}
+
+func (d *HostnameDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Hostname data source. Reads the SSL state of a pull zone hostname.",
+
+		Attributes: map[string]schema.Attribute{
+			"pullzone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the pull zone",
+				Required:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The name of the hostname.",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the hostname",
+				Computed:            true,
+			},
+			"has_certificate": schema.BoolAttribute{
+				MarkdownDescription: "Whether the hostname has a certificate",
+				Computed:            true,
+			},
+			"force_ssl": schema.BoolAttribute{
+				MarkdownDescription: "Whether the hostname forces SSL",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *HostnameDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.api = api
+}
+
+func (d *HostnameDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.HostnameDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteResource, err := d.api.HostnameGet(ctx, data.PullzoneId.ValueInt64(), data.Hostname.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hostname, got error: %s", err))
+		return
+	}
+
+	data = bunnycdn_api.HostnameToHostnameDataSourceModel(data.PullzoneId.ValueInt64(), remoteResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -7,21 +7,28 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/certificate_format"
 	"terraform-provider-bunnycdn/internal/model"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &HostnameResource{}
 var _ resource.ResourceWithImportState = &HostnameResource{}
+var _ resource.ResourceWithValidateConfig = &HostnameResource{}
+var _ resource.ResourceWithModifyPlan = &HostnameResource{}
 
 func NewHostnameResource() resource.Resource {
 	return &HostnameResource{}
@@ -79,17 +86,42 @@ func (r *HostnameResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"certificate": schema.StringAttribute{
-				MarkdownDescription: "Hostname custom certificate",
+				MarkdownDescription: "Hostname custom certificate as PEM, base64 encoded DER or base64 encoded PKCS#12 bundle",
 				Optional:            true,
 				Sensitive:           true,
 				PlanModifiers:       []planmodifier.String{},
 			},
 			"certificate_key": schema.StringAttribute{
-				MarkdownDescription: "Hostname custom certificate key",
+				MarkdownDescription: "Hostname custom certificate key as PEM or base64 encoded DER. Not needed when `certificate` is a PKCS#12 bundle",
 				Optional:            true,
 				Sensitive:           true,
 				PlanModifiers:       []planmodifier.String{},
 			},
+			"certificate_password": schema.StringAttribute{
+				MarkdownDescription: "Password of the PKCS#12 bundle given in `certificate`",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"dns_zone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of a Bunny DNS zone to create the DNS record of the hostname in, before the free certificate is loaded",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"dns_record_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the DNS record created in `dns_zone_id`, `CNAME` to the b-cdn.net hostname of the pull zone or `PullZone`. Only `PullZone` records can be created for the domain of the DNS zone itself",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("CNAME"),
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"dns_record_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the DNS record created in `dns_zone_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -114,6 +146,148 @@ func (r *HostnameResource) Configure(ctx context.Context, req resource.Configure
 	r.api = api
 }
 
+func (r *HostnameResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.HostnameResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateCertificate(data.Certificate, data.CertificateKey, data.CertificatePassword, &resp.Diagnostics)
+
+	if !data.DnsRecordType.IsNull() && !data.DnsRecordType.IsUnknown() {
+		dnsRecordType := data.DnsRecordType.ValueString()
+		if dnsRecordType != "CNAME" && dnsRecordType != "PullZone" {
+			resp.Diagnostics.AddAttributeError(path.Root("dns_record_type"), "Validation Error", "dns_record_type must be CNAME or PullZone")
+		}
+	}
+}
+
+func (r *HostnameResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on create and destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var data, state model.HostnameResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a DNS record deleted outside of Terraform has no ID in the state and is
+	// created again
+	dnsRecordMissing := !data.DnsZoneId.IsNull() && state.DnsRecordId.IsNull()
+	if !hostnameDnsRecordChanged(state, data) && !dnsRecordMissing {
+		return
+	}
+
+	dnsRecordId := types.Int64Unknown()
+	if data.DnsZoneId.IsNull() {
+		dnsRecordId = types.Int64Null()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dns_record_id"), dnsRecordId)...)
+}
+
+// hostnameDnsRecordChanged reports whether the DNS record of the hostname
+// has to be replaced.
+func hostnameDnsRecordChanged(state model.HostnameResourceModel, data model.HostnameResourceModel) bool {
+	if state.DnsZoneId.IsNull() && data.DnsZoneId.IsNull() {
+		return false
+	}
+	return !state.DnsZoneId.Equal(data.DnsZoneId) || !state.DnsRecordType.Equal(data.DnsRecordType) || !state.PullzoneId.Equal(data.PullzoneId)
+}
+
+// createDnsRecord points the hostname of data to its pull zone in the Bunny
+// DNS zone dns_zone_id and returns the ID of the record.
+func (r *HostnameResource) createDnsRecord(ctx context.Context, data model.HostnameResourceModel) (int64, error) {
+	dnsZone, err := r.api.DnsZoneGet(ctx, data.DnsZoneId.ValueInt64())
+	if err != nil {
+		return 0, err
+	}
+
+	hostname := strings.TrimSuffix(strings.ToLower(data.Hostname.ValueString()), ".")
+	domain := strings.ToLower(dnsZone.Domain)
+	name := ""
+	if hostname != domain {
+		if !strings.HasSuffix(hostname, "."+domain) {
+			return 0, fmt.Errorf("%s is not part of DNS zone %s", hostname, dnsZone.Domain)
+		}
+		name = strings.TrimSuffix(hostname, "."+domain)
+	}
+
+	pullzoneId := data.PullzoneId.ValueInt64()
+	record := bunnycdn_api.DnsRecord{
+		Ttl:  300,
+		Name: name,
+	}
+	if data.DnsRecordType.ValueString() == "PullZone" {
+		record.Type = bunnycdn_api.DnsRecordTypePullZone
+		record.PullZoneId = &pullzoneId
+	} else {
+		if name == "" {
+			return 0, fmt.Errorf("a CNAME record can not be created for %s, the domain of the DNS zone, use dns_record_type PullZone instead", dnsZone.Domain)
+		}
+		pullzone, err := r.api.PullzoneGet(ctx, pullzoneId)
+		if err != nil {
+			return 0, err
+		}
+		record.Type = bunnycdn_api.DnsRecordTypes["CNAME"]
+		record.Value = bunnycdn_api.PullzoneCdnDomain(pullzone)
+	}
+
+	createdRecord, err := r.api.DnsRecordCreate(ctx, dnsZone.Id, record)
+	if err != nil {
+		return 0, err
+	}
+	return createdRecord.Id, nil
+}
+
+// deleteDnsRecord deletes the DNS record created for the hostname of data.
+// Records that are already gone are ignored.
+func (r *HostnameResource) deleteDnsRecord(ctx context.Context, data model.HostnameResourceModel) error {
+	err := r.api.DnsRecordDelete(ctx, data.DnsZoneId.ValueInt64(), bunnycdn_api.DnsRecord{
+		Id:   data.DnsRecordId.ValueInt64(),
+		Name: data.Hostname.ValueString(),
+	})
+	if err != nil {
+		dnsRecordError, ok := err.(*model.DnsRecordError)
+		if ok && dnsRecordError.StatusCode == 404 {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// keepDnsRecord copies the DNS record settings of previous to data, as
+// bunny.net does not link the record to the hostname.
+func keepDnsRecord(data *model.HostnameResourceModel, previous model.HostnameResourceModel) {
+	data.DnsZoneId = previous.DnsZoneId
+	data.DnsRecordType = previous.DnsRecordType
+	data.DnsRecordId = previous.DnsRecordId
+}
+
+// addCertificate uploads the certificate of data converted to the format
+// bunny.net expects.
+func (r *HostnameResource) addCertificate(ctx context.Context, data model.HostnameResourceModel) error {
+	hostname := bunnycdn_api.HostnameResourceModelToHostname(data)
+
+	certificate, certificateKey, err := certificate_format.Normalize(data.Certificate.ValueString(), data.CertificateKey.ValueString(), data.CertificatePassword.ValueString())
+	if err != nil {
+		return err
+	}
+	hostname.Certificate = &certificate
+	hostname.CertificateKey = &certificateKey
+
+	return r.api.HostnameAddCertificate(ctx, data.PullzoneId.ValueInt64(), hostname)
+}
+
 func (r *HostnameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data model.HostnameResourceModel
 
@@ -130,6 +304,25 @@ func (r *HostnameResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// the free certificate can only be loaded once the hostname resolves
+	data.DnsRecordId = types.Int64Null()
+	if !data.DnsZoneId.IsNull() {
+		dnsRecordId, err := r.createDnsRecord(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS record, got error: %s", err))
+		} else {
+			data.DnsRecordId = types.Int64Value(dnsRecordId)
+		}
+	}
+
+	// save the hostname and its DNS record before the steps that can fail, so
+	// neither is left behind untracked
+	data.Id = types.Int64Null()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if data.EnableSsl.ValueBool() {
 		if data.Certificate.ValueStringPointer() == nil {
 			err := r.api.HostnameLoadFreeCertificate(ctx, data.PullzoneId.ValueInt64(), bunnycdn_api.HostnameResourceModelToHostname(data))
@@ -137,7 +330,7 @@ func (r *HostnameResource) Create(ctx context.Context, req resource.CreateReques
 				resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to load free certificate, got error: %s", err))
 			}
 		} else {
-			err := r.api.HostnameAddCertificate(ctx, data.PullzoneId.ValueInt64(), bunnycdn_api.HostnameResourceModelToHostname(data))
+			err := r.addCertificate(ctx, data)
 			if err != nil {
 				resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to add certificate, got error: %s", err))
 			}
@@ -170,7 +363,10 @@ func (r *HostnameResource) Create(ctx context.Context, req resource.CreateReques
 		remoteResource.CertificateKey = data.CertificateKey.ValueStringPointer()
 	}
 
+	previous := data
 	data = bunnycdn_api.HostnameToHostnameResourceModel(data.PullzoneId.ValueInt64(), remoteResource)
+	data.CertificatePassword = previous.CertificatePassword
+	keepDnsRecord(&data, previous)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -202,7 +398,25 @@ func (r *HostnameResource) Read(ctx context.Context, req resource.ReadRequest, r
 		remoteResource.CertificateKey = certificate.CertificateKey
 	}
 
+	// a DNS record deleted outside of Terraform is created again on update
+	if !data.DnsZoneId.IsNull() && !data.DnsRecordId.IsNull() {
+		_, err := r.api.DnsRecordGet(ctx, data.DnsZoneId.ValueInt64(), data.DnsRecordId.ValueInt64())
+		if err != nil {
+			dnsRecordError, isDnsRecordError := err.(*model.DnsRecordError)
+			dnsZoneError, isDnsZoneError := err.(*model.DnsZoneError)
+			if (isDnsRecordError && dnsRecordError.StatusCode == 404) || (isDnsZoneError && dnsZoneError.StatusCode == 404) {
+				data.DnsRecordId = types.Int64Null()
+			} else {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS record, got error: %s", err))
+				return
+			}
+		}
+	}
+
+	previous := data
 	data = bunnycdn_api.HostnameToHostnameResourceModel(data.PullzoneId.ValueInt64(), remoteResource)
+	data.CertificatePassword = previous.CertificatePassword
+	keepDnsRecord(&data, previous)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -216,13 +430,41 @@ func (r *HostnameResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	if data.EnableSsl.ValueBool() {
-		if state.EnableSsl.ValueBool() && state.Certificate.ValueStringPointer() == nil && data.Certificate.ValueStringPointer() != nil {
-			err := r.api.HostnameDeleteCertificate(ctx, data.PullzoneId.ValueInt64(), bunnycdn_api.HostnameResourceModelToHostname(data))
+	data.DnsRecordId = state.DnsRecordId
+	if hostnameDnsRecordChanged(state, data) || (state.DnsRecordId.IsNull() && !data.DnsZoneId.IsNull()) {
+		// the state keeps the old record until it is deleted, so the next apply
+		// deletes it again
+		if !state.DnsRecordId.IsNull() {
+			err := r.deleteDnsRecord(ctx, state)
 			if err != nil {
-				resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to delete certificate, got error: %s", err))
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS record, got error: %s", err))
+				return
 			}
-			err = r.api.HostnameAddCertificate(ctx, data.PullzoneId.ValueInt64(), bunnycdn_api.HostnameResourceModelToHostname(data))
+		}
+
+		data.DnsRecordId = types.Int64Null()
+		if !data.DnsZoneId.IsNull() {
+			dnsRecordId, err := r.createDnsRecord(ctx, data)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS record, got error: %s", err))
+			} else {
+				data.DnsRecordId = types.Int64Value(dnsRecordId)
+			}
+		}
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dns_zone_id"), data.DnsZoneId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dns_record_type"), data.DnsRecordType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dns_record_id"), data.DnsRecordId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.EnableSsl.ValueBool() {
+		// adding a certificate replaces the installed one, so rotating does not
+		// remove the current certificate first
+		if state.EnableSsl.ValueBool() && data.Certificate.ValueStringPointer() != nil &&
+			(!state.Certificate.Equal(data.Certificate) || !state.CertificateKey.Equal(data.CertificateKey) || !state.CertificatePassword.Equal(data.CertificatePassword)) {
+			err := r.addCertificate(ctx, data)
 			if err != nil {
 				resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to add certificate, got error: %s", err))
 			}
@@ -261,7 +503,7 @@ func (r *HostnameResource) Update(ctx context.Context, req resource.UpdateReques
 			}
 		}
 		if !state.EnableSsl.ValueBool() && data.Certificate.ValueStringPointer() != nil {
-			err := r.api.HostnameAddCertificate(ctx, data.PullzoneId.ValueInt64(), bunnycdn_api.HostnameResourceModelToHostname(data))
+			err := r.addCertificate(ctx, data)
 			if err != nil {
 				resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to add certificate, got error: %s", err))
 			}
@@ -293,7 +535,10 @@ func (r *HostnameResource) Update(ctx context.Context, req resource.UpdateReques
 			remoteResource.CertificateKey = data.CertificateKey.ValueStringPointer()
 		}
 
+		previous := data
 		data = bunnycdn_api.HostnameToHostnameResourceModel(data.PullzoneId.ValueInt64(), remoteResource)
+		data.CertificatePassword = previous.CertificatePassword
+		keepDnsRecord(&data, previous)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 	}
 }
@@ -313,8 +558,29 @@ func (r *HostnameResource) Delete(ctx context.Context, req resource.DeleteReques
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete hostname, got error: %s", err))
 		return
 	}
+
+	if !data.DnsZoneId.IsNull() && !data.DnsRecordId.IsNull() {
+		err := r.deleteDnsRecord(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS record, got error: %s", err))
+			return
+		}
+	}
 }
 
 func (r *HostnameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// validateCertificate rejects certificates that cannot be converted to the
+// format bunny.net expects. Unknown values are validated during apply.
+func validateCertificate(certificate types.String, certificateKey types.String, certificatePassword types.String, diagnostics *diag.Diagnostics) {
+	if certificate.IsNull() || certificate.IsUnknown() || certificateKey.IsUnknown() || certificatePassword.IsUnknown() {
+		return
+	}
+
+	_, _, err := certificate_format.Normalize(certificate.ValueString(), certificateKey.ValueString(), certificatePassword.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("certificate"), "Validation Error", fmt.Sprintf("Invalid certificate: %s", err))
+	}
+}
@@ -56,11 +56,30 @@ func (p *BunnyCdnProvider) Resources(ctx context.Context) []func() resource.Reso
 	return []func() resource.Resource{
 		NewPullzoneResource,
 		NewHostnameResource,
+		NewPullzoneCertificateResource,
+		NewAcmeCertificateResource,
+		NewPullzoneHostnamesResource,
+		NewStoragezoneResource,
+		NewStorageObjectResource,
+		NewStorageDirectoryResource,
+		NewEdgeRuleResource,
+		NewPullzoneEdgeRulesResource,
+		NewRedirectMapResource,
+		NewDnsZoneResource,
+		NewDnsRecordResource,
+		NewDnsZoneImportResource,
 	}
 }
 
 func (p *BunnyCdnProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewPullzoneDataSource,
+		NewPullzonesDataSource,
+		NewHostnameDataSource,
+		NewPullzoneNameAvailabilityDataSource,
+		NewStoragezoneDataSource,
+		NewStorageObjectDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/certificate_format"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PullzoneCertificateResource{}
+var _ resource.ResourceWithValidateConfig = &PullzoneCertificateResource{}
+
+func NewPullzoneCertificateResource() resource.Resource {
+	return &PullzoneCertificateResource{}
+}
+
+type PullzoneCertificateResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (r *PullzoneCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pullzone_certificate"
+}
+
+func (r *PullzoneCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom certificate of a pull zone hostname. Changing the certificate uploads the new one over the " +
+			"existing one in place, so the hostname keeps serving a valid certificate during rotation. " +
+			"The hostname itself must not set `certificate` when it is managed by this resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"pullzone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the pull zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname the certificate is installed on.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				MarkdownDescription: "Hostname custom certificate as PEM, base64 encoded DER or base64 encoded PKCS#12 bundle",
+				Required:            true,
+				Sensitive:           true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"certificate_key": schema.StringAttribute{
+				MarkdownDescription: "Hostname custom certificate key as PEM or base64 encoded DER. Not needed when `certificate` is a PKCS#12 bundle",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"certificate_password": schema.StringAttribute{
+				MarkdownDescription: "Password of the PKCS#12 bundle given in `certificate`",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the hostname",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PullzoneCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *PullzoneCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.PullzoneCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateCertificate(data.Certificate, data.CertificateKey, data.CertificatePassword, &resp.Diagnostics)
+}
+
+// addCertificate uploads the certificate of data converted to the format
+// bunny.net expects.
+func (r *PullzoneCertificateResource) addCertificate(ctx context.Context, data model.PullzoneCertificateResourceModel) error {
+	hostname := bunnycdn_api.PullzoneCertificateResourceModelToHostname(data)
+
+	certificate, certificateKey, err := certificate_format.Normalize(data.Certificate.ValueString(), data.CertificateKey.ValueString(), data.CertificatePassword.ValueString())
+	if err != nil {
+		return err
+	}
+	hostname.Certificate = &certificate
+	hostname.CertificateKey = &certificateKey
+
+	return r.api.HostnameAddCertificate(ctx, data.PullzoneId.ValueInt64(), hostname)
+}
+
+func (r *PullzoneCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.PullzoneCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.addCertificate(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add certificate, got error: %s", err))
+		return
+	}
+
+	remoteResource, err := r.api.HostnameGet(ctx, data.PullzoneId.ValueInt64(), data.Hostname.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hostname, got error: %s", err))
+		return
+	}
+
+	data.Id = types.Int64Value(remoteResource.Id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullzoneCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.PullzoneCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteResource, err := r.api.HostnameGet(ctx, data.PullzoneId.ValueInt64(), data.Hostname.ValueString())
+	if err != nil {
+		hostnameError, ok := err.(*model.HostnameError)
+		if ok && hostnameError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hostname, got error: %s", err))
+		return
+	}
+
+	// the certificate was removed outside of terraform
+	if !remoteResource.EnableSsl {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.Int64Value(remoteResource.Id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullzoneCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data model.PullzoneCertificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// adding a certificate replaces the installed one, removing it first would
+	// leave the hostname without a certificate until the new one is added
+	err := r.addCertificate(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add certificate, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullzoneCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.PullzoneCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.HostnameDeleteCertificate(ctx, data.PullzoneId.ValueInt64(), bunnycdn_api.PullzoneCertificateResourceModelToHostname(data))
+	if err != nil {
+		deleteCertificateError, ok := err.(*model.DeleteCertificateError)
+		if ok && deleteCertificateError.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete certificate, got error: %s", err))
+		return
+	}
+}
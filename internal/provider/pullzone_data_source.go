@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &PullzoneDataSource{}
+
+func NewPullzoneDataSource() datasource.DataSource {
+	return &PullzoneDataSource{}
+}
+
+type PullzoneDataSource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (d *PullzoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pullzone"
+}
+
+func (d *PullzoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := pullzoneDataSourceAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		MarkdownDescription: "The ID of the pull zone",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the pull zone.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pull zone data source. Looks up a pull zone by `id` or by exact `name`.",
+		Attributes:          attributes,
+	}
+}
+
+// pullzoneDataSourceAttributes returns the attributes of a pull zone read
+// from the API, shared by the pull zone data sources.
+func pullzoneDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the pull zone",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the pull zone.",
+			Computed:            true,
+		},
+		"origin_type": schema.Int64Attribute{
+			MarkdownDescription: "The origin type of the pull zone (0 = OriginUrl, 2 = StorageZone)",
+			Computed:            true,
+		},
+		"storage_zone_id": schema.Int64Attribute{
+			MarkdownDescription: "The ID of the storage zone that is used as the origin",
+			Computed:            true,
+		},
+		"origin_url": schema.StringAttribute{
+			MarkdownDescription: "The origin URL of the pull zone",
+			Computed:            true,
+		},
+		"origin_host_header": schema.StringAttribute{
+			MarkdownDescription: "The host header that is sent to the origin",
+			Computed:            true,
+		},
+		"enable_smart_cache": schema.BoolAttribute{
+			MarkdownDescription: "Whether smart cache is enabled",
+			Computed:            true,
+		},
+		"disable_cookie": schema.BoolAttribute{
+			MarkdownDescription: "Whether cookies are disabled",
+			Computed:            true,
+		},
+		"error_page_enable_custom_code": schema.BoolAttribute{
+			MarkdownDescription: "Whether the custom error page is enabled",
+			Computed:            true,
+		},
+		"error_page_custom_code": schema.StringAttribute{
+			MarkdownDescription: "The template of the custom error page",
+			Computed:            true,
+		},
+		"hostnames": schema.ListNestedAttribute{
+			MarkdownDescription: "The hostnames of the pull zone",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						MarkdownDescription: "The ID of the hostname",
+						Computed:            true,
+					},
+					"value": schema.StringAttribute{
+						MarkdownDescription: "The hostname",
+						Computed:            true,
+					},
+					"has_certificate": schema.BoolAttribute{
+						MarkdownDescription: "Whether the hostname has a certificate",
+						Computed:            true,
+					},
+					"force_ssl": schema.BoolAttribute{
+						MarkdownDescription: "Whether the hostname forces SSL",
+						Computed:            true,
+					},
+					"is_system_hostname": schema.BoolAttribute{
+						MarkdownDescription: "Whether the hostname is the system `b-cdn.net` hostname",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"cdn_domain": schema.StringAttribute{
+			MarkdownDescription: "The system `b-cdn.net` hostname of the pull zone, to be used as CNAME target",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *PullzoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.api = api
+}
+
+func (d *PullzoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.PullzoneDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddError("Validation Error", "exactly one of id or name must be set")
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	if data.Id.IsNull() {
+		pullzones, err := d.api.PullzoneList(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list pull zones, got error: %s", err))
+			return
+		}
+
+		found := false
+		for _, item := range pullzones {
+			if item.Name == data.Name.ValueString() {
+				id = item.Id
+				found = true
+				break
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Pull zone with name %s does not exist", data.Name.ValueString()))
+			return
+		}
+	}
+
+	remoteResource, err := d.api.PullzoneGet(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pull zone, got error: %s", err))
+		return
+	}
+
+	data = bunnycdn_api.PullzoneToPullzoneDataSourceModel(remoteResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PullzoneEdgeRulesResource{}
+var _ resource.ResourceWithImportState = &PullzoneEdgeRulesResource{}
+var _ resource.ResourceWithValidateConfig = &PullzoneEdgeRulesResource{}
+
+func NewPullzoneEdgeRulesResource() resource.Resource {
+	return &PullzoneEdgeRulesResource{}
+}
+
+type PullzoneEdgeRulesResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (r *PullzoneEdgeRulesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pullzone_edge_rules"
+}
+
+func (r *PullzoneEdgeRulesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ruleAttributes := edgeRuleAttributes()
+	ruleAttributes["id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The GUID of the edge rule",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritative, ordered list of the edge rules of a pull zone. Edge rules are matched with the rules " +
+			"of the pull zone by `description`, or by content or GUID when they have no description, and updated in place. bunny.net " +
+			"runs edge rules in the order they were created, so the rules from the first one that is out of place onwards are " +
+			"recreated in order, each before its old copy is deleted. Edge rules that are not in `rules` are deleted last. Do not " +
+			"combine it with `bunnycdn_edge_rule` on the same pull zone.",
+
+		Attributes: map[string]schema.Attribute{
+			"pullzone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the pull zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The edge rules in execution order",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ruleAttributes,
+				},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the pull zone",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PullzoneEdgeRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *PullzoneEdgeRulesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.PullzoneEdgeRulesResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	descriptions := map[string]bool{}
+	for i, rule := range data.Rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+		validateEdgeRule(bunnycdn_api.PullzoneEdgeRulesRuleModelToEdgeRuleResourceModel(0, rule), rulePath, &resp.Diagnostics)
+
+		if rule.Description.IsNull() || rule.Description.IsUnknown() {
+			continue
+		}
+		if descriptions[rule.Description.ValueString()] {
+			resp.Diagnostics.AddAttributeError(rulePath.AtName("description"), "Validation Error",
+				fmt.Sprintf("description %s is used by more than one edge rule", rule.Description.ValueString()))
+		}
+		descriptions[rule.Description.ValueString()] = true
+	}
+}
+
+func (r *PullzoneEdgeRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.PullzoneEdgeRulesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullzoneEdgeRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.PullzoneEdgeRulesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	edgeRules, err := r.api.EdgeRuleList(ctx, data.PullzoneId.ValueInt64())
+	if err != nil {
+		pullzoneError, ok := err.(*model.PullzoneError)
+		if ok && pullzoneError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read edge rules, got error: %s", err))
+		return
+	}
+
+	data.Id = data.PullzoneId
+	data.Rules = []model.PullzoneEdgeRulesRuleModel{}
+	for _, item := range edgeRules {
+		data.Rules = append(data.Rules, bunnycdn_api.EdgeRuleToPullzoneEdgeRulesRuleModel(&item))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullzoneEdgeRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state model.PullzoneEdgeRulesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, state.Rules, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullzoneEdgeRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.PullzoneEdgeRulesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, rule := range data.Rules {
+		err := r.api.EdgeRuleDelete(ctx, data.PullzoneId.ValueInt64(), rule.Id.ValueString())
+		if err != nil {
+			edgeRuleError, ok := err.(*model.EdgeRuleError)
+			if ok && edgeRuleError.StatusCode == 404 {
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete edge rule %s, got error: %s", rule.Id.ValueString(), err))
+		}
+	}
+}
+
+func (r *PullzoneEdgeRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pullzoneId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected a pull zone ID, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), pullzoneId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pullzone_id"), pullzoneId)...)
+}
+
+// matchEdgeRules returns the GUID of the edge rule of the pull zone each rule
+// describes, or "" for rules that do not exist yet. Rules are matched by
+// description, else by content and else by the GUID they had before this
+// apply, regardless of their position. previous holds the rules before this
+// apply.
+func matchEdgeRules(pullzoneId int64, rules []model.PullzoneEdgeRulesRuleModel, remote []bunnycdn_api.EdgeRule, previous []model.PullzoneEdgeRulesRuleModel) []string {
+	matched := make([]string, len(rules))
+	used := map[string]bool{}
+	match := func(i int, same func(remote bunnycdn_api.EdgeRule) bool) {
+		for _, item := range remote {
+			if !used[item.Guid] && same(item) {
+				matched[i] = item.Guid
+				used[item.Guid] = true
+				return
+			}
+		}
+	}
+
+	for i, rule := range rules {
+		if !rule.Description.IsNull() {
+			match(i, func(remote bunnycdn_api.EdgeRule) bool {
+				return remote.Description != nil && *remote.Description == rule.Description.ValueString()
+			})
+		}
+	}
+	for i, rule := range rules {
+		if rule.Description.IsNull() {
+			edgeRule := bunnycdn_api.EdgeRuleNormalize(bunnycdn_api.EdgeRuleResourceModelToEdgeRule(bunnycdn_api.PullzoneEdgeRulesRuleModelToEdgeRuleResourceModel(pullzoneId, rule)))
+			match(i, func(remote bunnycdn_api.EdgeRule) bool {
+				return reflect.DeepEqual(bunnycdn_api.EdgeRuleNormalize(remote), edgeRule)
+			})
+		}
+	}
+	for i, rule := range rules {
+		if rule.Description.IsNull() && matched[i] == "" && i < len(previous) && !previous[i].Id.IsNull() {
+			previousGuid := previous[i].Id.ValueString()
+			match(i, func(remote bunnycdn_api.EdgeRule) bool {
+				return remote.Guid == previousGuid
+			})
+		}
+	}
+	return matched
+}
+
+// apply makes the edge rules of the pull zone match data without leaving the
+// pull zone unprotected in between. Matched edge rules are updated in place
+// and new ones created. bunny.net runs edge rules in the order they were
+// created, so the rules from the first one that is out of place onwards are
+// recreated in order, each before its old copy is deleted. Edge rules that
+// are not in data are deleted last. previous holds the rules before this
+// apply.
+func (r *PullzoneEdgeRulesResource) apply(ctx context.Context, data *model.PullzoneEdgeRulesResourceModel, previous []model.PullzoneEdgeRulesRuleModel, diagnostics *diag.Diagnostics) {
+	pullzoneId := data.PullzoneId.ValueInt64()
+
+	remote, err := r.api.EdgeRuleList(ctx, pullzoneId)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read edge rules, got error: %s", err))
+		return
+	}
+
+	matched := matchEdgeRules(pullzoneId, data.Rules, remote, previous)
+	edgeRules := []bunnycdn_api.EdgeRule{}
+	for i, rule := range data.Rules {
+		edgeRule := bunnycdn_api.EdgeRuleResourceModelToEdgeRule(bunnycdn_api.PullzoneEdgeRulesRuleModelToEdgeRuleResourceModel(pullzoneId, rule))
+		edgeRule.Guid = matched[i]
+		edgeRules = append(edgeRules, edgeRule)
+	}
+
+	kept := map[string]bool{}
+	for _, guid := range matched {
+		kept[guid] = guid != ""
+	}
+
+	// the matched edge rules in their current order
+	remoteByGuid := map[string]bunnycdn_api.EdgeRule{}
+	order := []string{}
+	for _, item := range remote {
+		remoteByGuid[item.Guid] = item
+		if kept[item.Guid] {
+			order = append(order, item.Guid)
+		}
+	}
+	inPlace := 0
+	for inPlace < len(matched) && inPlace < len(order) && matched[inPlace] == order[inPlace] {
+		inPlace++
+	}
+
+	for _, edgeRule := range edgeRules[:inPlace] {
+		// remote is normalized the same way so unset and empty parameters compare equal
+		if !reflect.DeepEqual(bunnycdn_api.EdgeRuleNormalize(edgeRule), bunnycdn_api.EdgeRuleNormalize(remoteByGuid[edgeRule.Guid])) {
+			_, err := r.api.EdgeRuleAddOrUpdate(ctx, pullzoneId, edgeRule)
+			if err != nil {
+				diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update edge rule %s, got error: %s", edgeRule.Guid, err))
+				return
+			}
+		}
+	}
+
+	for _, edgeRule := range edgeRules[inPlace:] {
+		oldGuid := edgeRule.Guid
+		edgeRule.Guid = ""
+		_, err := r.api.EdgeRuleAddOrUpdate(ctx, pullzoneId, edgeRule)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create edge rule, got error: %s", err))
+			return
+		}
+		if oldGuid != "" {
+			err := r.api.EdgeRuleDelete(ctx, pullzoneId, oldGuid)
+			if err != nil {
+				diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete edge rule %s, got error: %s", oldGuid, err))
+				return
+			}
+		}
+	}
+
+	for _, item := range remote {
+		if kept[item.Guid] {
+			continue
+		}
+		err := r.api.EdgeRuleDelete(ctx, pullzoneId, item.Guid)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete edge rule %s, got error: %s", item.Guid, err))
+			return
+		}
+	}
+
+	remote, err = r.api.EdgeRuleList(ctx, pullzoneId)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read edge rules, got error: %s", err))
+		return
+	}
+	if len(remote) != len(data.Rules) {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Expected %d edge rules on pull zone %d, found %d", len(data.Rules), pullzoneId, len(remote)))
+		return
+	}
+
+	for i := range data.Rules {
+		data.Rules[i].Id = types.StringValue(remote[i].Guid)
+	}
+	data.Id = types.Int64Value(pullzoneId)
+}
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PullzoneHostnamesResource{}
+var _ resource.ResourceWithImportState = &PullzoneHostnamesResource{}
+
+func NewPullzoneHostnamesResource() resource.Resource {
+	return &PullzoneHostnamesResource{}
+}
+
+type PullzoneHostnamesResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (r *PullzoneHostnamesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pullzone_hostnames"
+}
+
+func (r *PullzoneHostnamesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritative set of hostnames of a pull zone. Only the hostnames that differ from the pull zone are " +
+			"added, removed or updated. Do not combine it with `bunnycdn_hostname` on the same pull zone.",
+
+		Attributes: map[string]schema.Attribute{
+			"pullzone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the pull zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"hostnames": schema.MapNestedAttribute{
+				MarkdownDescription: "SSL settings of the hostnames, keyed by hostname",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"enable_ssl": schema.BoolAttribute{
+							MarkdownDescription: "Sets enable SSL",
+							Computed:            true,
+							Optional:            true,
+							Default:             booldefault.StaticBool(true),
+							PlanModifiers:       []planmodifier.Bool{},
+						},
+						"force_ssl": schema.BoolAttribute{
+							MarkdownDescription: "Sets force SSL",
+							Computed:            true,
+							Optional:            true,
+							Default:             booldefault.StaticBool(true),
+							PlanModifiers:       []planmodifier.Bool{},
+						},
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the hostname",
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"remove_unmanaged": schema.BoolAttribute{
+				MarkdownDescription: "Removes hostnames of the pull zone that are not in `hostnames`. The system `b-cdn.net` hostname is never removed",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers:       []planmodifier.Bool{},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the pull zone",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PullzoneHostnamesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *PullzoneHostnamesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.PullzoneHostnamesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullzoneHostnamesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.PullzoneHostnamesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pullzone, err := r.api.PullzoneGet(ctx, data.PullzoneId.ValueInt64())
+	if err != nil {
+		pullzoneError, ok := err.(*model.PullzoneError)
+		if ok && pullzoneError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pull zone, got error: %s", err))
+		return
+	}
+
+	// imported state has no hostnames yet, so it takes over all of them
+	imported := data.Hostnames == nil
+	hostnames := map[string]model.PullzoneHostnamesHostnameModel{}
+	for _, item := range pullzone.Hostnames {
+		if item.IsSystemHostname {
+			continue
+		}
+		_, managed := data.Hostnames[item.Value]
+		if managed || imported || data.RemoveUnmanaged.ValueBool() {
+			hostnames[item.Value] = bunnycdn_api.PullzoneHostnameToPullzoneHostnamesHostnameModel(item)
+		}
+	}
+
+	data.Id = types.Int64Value(pullzone.Id)
+	data.Hostnames = hostnames
+	if data.RemoveUnmanaged.IsNull() {
+		data.RemoveUnmanaged = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullzoneHostnamesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state model.PullzoneHostnamesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, state.Hostnames, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullzoneHostnamesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.PullzoneHostnamesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for hostname := range data.Hostnames {
+		err := r.api.HostnameDelete(ctx, data.PullzoneId.ValueInt64(), bunnycdn_api.Hostname{Hostname: hostname})
+		if err != nil {
+			hostnameError, ok := err.(*model.HostnameError)
+			if ok && hostnameError.StatusCode == 404 {
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete hostname %s, got error: %s", hostname, err))
+		}
+	}
+}
+
+func (r *PullzoneHostnamesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pullzoneId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected a pull zone ID, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), pullzoneId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pullzone_id"), pullzoneId)...)
+}
+
+// apply makes the hostnames of the pull zone match data. previous holds the
+// hostnames managed before this apply, which are removed when they are no
+// longer in data.
+func (r *PullzoneHostnamesResource) apply(ctx context.Context, data *model.PullzoneHostnamesResourceModel, previous map[string]model.PullzoneHostnamesHostnameModel, diagnostics *diag.Diagnostics) {
+	pullzoneId := data.PullzoneId.ValueInt64()
+
+	pullzone, err := r.api.PullzoneGet(ctx, pullzoneId)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pull zone, got error: %s", err))
+		return
+	}
+
+	remote := map[string]bunnycdn_api.PullzoneHostname{}
+	for _, item := range pullzone.Hostnames {
+		if !item.IsSystemHostname {
+			remote[item.Value] = item
+		}
+	}
+
+	for hostname, remoteHostname := range remote {
+		if _, ok := data.Hostnames[hostname]; ok {
+			continue
+		}
+		if _, ok := previous[hostname]; !ok && !data.RemoveUnmanaged.ValueBool() {
+			continue
+		}
+		err := r.api.HostnameDelete(ctx, pullzoneId, bunnycdn_api.Hostname{Id: remoteHostname.Id, Hostname: hostname})
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete hostname %s, got error: %s", hostname, err))
+		}
+	}
+
+	for hostname, settings := range data.Hostnames {
+		item := bunnycdn_api.Hostname{
+			Hostname:  hostname,
+			EnableSsl: settings.EnableSsl.ValueBool(),
+			ForceSsl:  settings.ForceSsl.ValueBool(),
+		}
+
+		remoteHostname, exists := remote[hostname]
+		if !exists {
+			err := r.api.HostnameCreate(ctx, pullzoneId, item)
+			if err != nil {
+				diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create hostname %s, got error: %s", hostname, err))
+				continue
+			}
+		}
+
+		if item.EnableSsl && (!exists || !remoteHostname.HasCertificate) {
+			err := r.api.HostnameLoadFreeCertificate(ctx, pullzoneId, item)
+			if err != nil {
+				diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to load free certificate for %s, got error: %s", hostname, err))
+			}
+		}
+		if !item.EnableSsl && exists && remoteHostname.HasCertificate {
+			err := r.api.HostnameDeleteCertificate(ctx, pullzoneId, item)
+			if err != nil {
+				diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to delete certificate of %s, got error: %s", hostname, err))
+			}
+		}
+		if !exists || remoteHostname.ForceSsl != item.ForceSsl {
+			err := r.api.HostnameUpdateForceSsl(ctx, pullzoneId, item)
+			if err != nil {
+				diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to update force_ssl of %s, got error: %s", hostname, err))
+			}
+		}
+	}
+
+	if diagnostics.HasError() {
+		return
+	}
+
+	pullzone, err = r.api.PullzoneGet(ctx, pullzoneId)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pull zone, got error: %s", err))
+		return
+	}
+
+	// ssl settings keep the planned values, differences show up on the next refresh
+	for _, item := range pullzone.Hostnames {
+		settings, ok := data.Hostnames[item.Value]
+		if ok {
+			settings.Id = types.Int64Value(item.Id)
+			data.Hostnames[item.Value] = settings
+		}
+	}
+	data.Id = types.Int64Value(pullzone.Id)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PullzoneNameAvailabilityDataSource{}
+
+func NewPullzoneNameAvailabilityDataSource() datasource.DataSource {
+	return &PullzoneNameAvailabilityDataSource{}
+}
+
+type PullzoneNameAvailabilityDataSource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (d *PullzoneNameAvailabilityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pullzone_name_availability"
+}
+
+func (d *PullzoneNameAvailabilityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks whether a pull zone name is still available on b-cdn.net.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the pull zone.",
+				Required:            true,
+			},
+			"available": schema.BoolAttribute{
+				MarkdownDescription: "Whether the name is available",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PullzoneNameAvailabilityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.api = api
+}
+
+func (d *PullzoneNameAvailabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.PullzoneNameAvailabilityDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	available, err := d.api.PullzoneCheckAvailability(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check pull zone name availability, got error: %s", err))
+		return
+	}
+
+	data.Available = types.BoolValue(available)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
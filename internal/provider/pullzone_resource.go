@@ -10,6 +10,7 @@ import (
 	"terraform-provider-bunnycdn/internal/bunnycdn_api"
 	"terraform-provider-bunnycdn/internal/model"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,11 +19,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &PullzoneResource{}
 var _ resource.ResourceWithImportState = &PullzoneResource{}
+var _ resource.ResourceWithModifyPlan = &PullzoneResource{}
 
 func NewPullzoneResource() resource.Resource {
 	return &PullzoneResource{}
@@ -42,9 +46,11 @@ func (r *PullzoneResource) Schema(ctx context.Context, req resource.SchemaReques
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the pull zone.",
+				MarkdownDescription: "The name of the pull zone. Changing it replaces the pull zone.",
 				Required:            true,
-				PlanModifiers:       []planmodifier.String{},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"origin_type": schema.Int64Attribute{
 				MarkdownDescription: "Sets the origin type of the pull zone (0 = OriginUrl, 2 = StorageZone)",
@@ -94,6 +100,48 @@ func (r *PullzoneResource) Schema(ctx context.Context, req resource.SchemaReques
 				Optional:            true,
 				PlanModifiers:       []planmodifier.String{},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevents the pull zone from being deleted. Must be set to false and applied before the pull zone can be destroyed",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+				PlanModifiers:       []planmodifier.Bool{},
+			},
+			"hostnames": schema.ListNestedAttribute{
+				MarkdownDescription: "The hostnames of the pull zone",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the hostname",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The hostname",
+							Computed:            true,
+						},
+						"has_certificate": schema.BoolAttribute{
+							MarkdownDescription: "Whether the hostname has a certificate",
+							Computed:            true,
+						},
+						"force_ssl": schema.BoolAttribute{
+							MarkdownDescription: "Whether the hostname forces SSL",
+							Computed:            true,
+						},
+						"is_system_hostname": schema.BoolAttribute{
+							MarkdownDescription: "Whether the hostname is the system `b-cdn.net` hostname",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"cdn_domain": schema.StringAttribute{
+				MarkdownDescription: "The system `b-cdn.net` hostname of the pull zone, to be used as CNAME target",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"id": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The ID of the pull zone",
@@ -137,6 +185,70 @@ func (r *PullzoneResource) Validate(ctx context.Context, data model.PullzoneReso
 	}
 }
 
+// pullzoneImmutableAttributes maps the attributes bunny.net cannot change on an
+// existing pull zone to the reason shown when a change replaces the pull zone.
+var pullzoneImmutableAttributes = map[string]string{
+	"name": "bunny.net does not support renaming pull zones",
+}
+
+func (r *PullzoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, state model.PullzoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		for name, reason := range pullzoneImmutableAttributes {
+			var planValue, stateValue attr.Value
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &stateValue)...)
+			if planValue == nil || planValue.IsUnknown() || planValue.Equal(stateValue) {
+				continue
+			}
+
+			detail := fmt.Sprintf("%s cannot be changed in place because %s. The pull zone will be destroyed and recreated, "+
+				"which interrupts traffic and assigns a new id and cdn_domain.", name, reason)
+			if state.DeletionProtection.ValueBool() {
+				detail += " deletion_protection is enabled, so the replacement fails until deletion_protection = false has been applied."
+			}
+			resp.Diagnostics.AddAttributeWarning(path.Root(name), "Pull zone will be replaced", detail)
+		}
+	}
+
+	if r.api == nil || data.Name.IsUnknown() {
+		return
+	}
+
+	// only new pull zones and renames need a free name
+	if !req.State.Raw.IsNull() && state.Name.Equal(data.Name) {
+		return
+	}
+
+	available, err := r.api.PullzoneCheckAvailability(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to check pull zone name availability, got error: %s", err))
+		return
+	}
+	if !available {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Pull zone name unavailable",
+			fmt.Sprintf("The pull zone name %s is already taken on b-cdn.net. Pull zone names are globally unique, please choose another name.", data.Name.ValueString()),
+		)
+	}
+}
+
 func (r *PullzoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data model.PullzoneResourceModel
 
@@ -154,7 +266,9 @@ func (r *PullzoneResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	deletionProtection := data.DeletionProtection
 	data = bunnycdn_api.PullzoneToPullzoneResourceModel(createdResource)
+	data.DeletionProtection = deletionProtection
 	tflog.Trace(ctx, "created a pull zone")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -181,7 +295,13 @@ func (r *PullzoneResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	deletionProtection := data.DeletionProtection
+	// imported pull zones and states written before deletion_protection existed are protected
+	if deletionProtection.IsNull() {
+		deletionProtection = types.BoolValue(true)
+	}
 	data = bunnycdn_api.PullzoneToPullzoneResourceModel(remoteResource)
+	data.DeletionProtection = deletionProtection
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -202,7 +322,9 @@ func (r *PullzoneResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	deletionProtection := data.DeletionProtection
 	data = bunnycdn_api.PullzoneToPullzoneResourceModel(remoteResource)
+	data.DeletionProtection = deletionProtection
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -216,6 +338,14 @@ func (r *PullzoneResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection",
+			fmt.Sprintf("Pull zone %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", data.Name.ValueString()),
+		)
+		return
+	}
+
 	err := r.api.PullzoneDelete(ctx, bunnycdn_api.PullzoneResourceModelToPullzone(data))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete pull zone, got error: %s", err))
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var _ datasource.DataSource = &PullzonesDataSource{}
+
+func NewPullzonesDataSource() datasource.DataSource {
+	return &PullzonesDataSource{}
+}
+
+type PullzonesDataSource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (d *PullzonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pullzones"
+}
+
+func (d *PullzonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the pull zones of the account, optionally filtered.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return pull zones whose name matches this regular expression",
+				Optional:            true,
+			},
+			"origin_type": schema.Int64Attribute{
+				MarkdownDescription: "Only return pull zones with this origin type (0 = OriginUrl, 2 = StorageZone)",
+				Optional:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Only return pull zones that have this hostname attached",
+				Optional:            true,
+			},
+			"pullzones": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching pull zones",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: pullzoneDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *PullzonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.api = api
+}
+
+func (d *PullzonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.PullzonesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Validation Error", fmt.Sprintf("Invalid regular expression: %s", err))
+			return
+		}
+	}
+
+	pullzones, err := d.api.PullzoneList(ctx, "")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list pull zones, got error: %s", err))
+		return
+	}
+
+	data.Pullzones = []model.PullzoneDataSourceModel{}
+	for i := range pullzones {
+		item := &pullzones[i]
+		if nameRegex != nil && !nameRegex.MatchString(item.Name) {
+			continue
+		}
+		if !data.OriginType.IsNull() && item.OriginType != data.OriginType.ValueInt64() {
+			continue
+		}
+		if !data.Hostname.IsNull() && !pullzoneHasHostname(item, data.Hostname.ValueString()) {
+			continue
+		}
+		data.Pullzones = append(data.Pullzones, bunnycdn_api.PullzoneToPullzoneDataSourceModel(item))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func pullzoneHasHostname(pullzone *bunnycdn_api.Pullzone, hostname string) bool {
+	for _, item := range pullzone.Hostnames {
+		if item.Value == hostname {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,566 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &RedirectMapResource{}
+var _ resource.ResourceWithValidateConfig = &RedirectMapResource{}
+var _ resource.ResourceWithModifyPlan = &RedirectMapResource{}
+
+func NewRedirectMapResource() resource.Resource {
+	return &RedirectMapResource{}
+}
+
+type RedirectMapResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+// redirect is a single entry of a redirect map.
+type redirect struct {
+	source     string
+	target     string
+	statusCode int64
+}
+
+func (r *RedirectMapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redirect_map"
+}
+
+func (r *RedirectMapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Redirect map of a pull zone, compiled into as few redirect edge rules as possible. "+
+			"Redirects with the same target and status code share edge rules, with up to %d triggers of %d paths each per edge rule. "+
+			"The plan shows the resulting `rule_count`. Do not combine it with `bunnycdn_pullzone_edge_rules` on the same pull zone.",
+			bunnycdn_api.EdgeRuleMaxTriggers, bunnycdn_api.EdgeRuleMaxPatternMatches),
+
+		Attributes: map[string]schema.Attribute{
+			"pullzone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the pull zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the redirect map, used in the descriptions of its edge rules",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"redirects": schema.MapAttribute{
+				MarkdownDescription: "The target URLs keyed by the source path, e.g. `\"/old\" = \"https://example.com/new\"`. Source paths start with `/` and contain no `*` or `?`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"csv_file": schema.StringAttribute{
+				MarkdownDescription: "The path of a CSV file with the columns source path, target URL and optionally status code. " +
+					"A first row starting with `source` is skipped. Combined with `redirects` when both are set",
+				Optional: true,
+			},
+			"status_code": schema.Int64Attribute{
+				MarkdownDescription: "The status code of redirects that do not set one (301, 302, 307 or 308)",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(301),
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname the source paths are redirected on. Paths are matched as `<scheme>://<hostname><path>`, so `/about` does not match `/team/about`",
+				Required:            true,
+			},
+			"rule_ids": schema.ListAttribute{
+				MarkdownDescription: "The GUIDs of the edge rules of the redirect map",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"rule_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of edge rules the redirect map is compiled into",
+				Computed:            true,
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "The SHA256 checksum of the compiled edge rules, changes when the redirects or the CSV file change",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the redirect map in the form `<pullzone_id>/<name>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RedirectMapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *RedirectMapResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.RedirectMapResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Redirects.IsNull() && data.CsvFile.IsNull() {
+		resp.Diagnostics.AddError("Validation Error", "at least one of redirects or csv_file must be set")
+	}
+	if !data.StatusCode.IsNull() && !data.StatusCode.IsUnknown() && !redirectStatusCode(data.StatusCode.ValueInt64()) {
+		resp.Diagnostics.AddAttributeError(path.Root("status_code"), "Validation Error", "status_code must be one of 301, 302, 307 or 308")
+	}
+	if !data.Redirects.IsUnknown() {
+		for source := range data.Redirects.Elements() {
+			if message := redirectSourcePath(source); message != "" {
+				resp.Diagnostics.AddAttributeError(path.Root("redirects").AtMapKey(source), "Validation Error", message)
+			}
+		}
+	}
+}
+
+// redirectSourcePath checks that source is a plain path, as it is put into a
+// trigger pattern where * is a wildcard and query strings never match. It
+// returns an error message when source is not accepted.
+func redirectSourcePath(source string) string {
+	if !strings.HasPrefix(source, "/") {
+		return fmt.Sprintf("source path %s must start with /", source)
+	}
+	if strings.ContainsAny(source, "*?") {
+		return fmt.Sprintf("source path %s must not contain * or ?", source)
+	}
+	return ""
+}
+
+func redirectStatusCode(statusCode int64) bool {
+	return statusCode == 301 || statusCode == 302 || statusCode == 307 || statusCode == 308
+}
+
+// redirectMapRedirects returns the redirects of data from redirects and
+// csv_file, sorted by source path.
+func redirectMapRedirects(data model.RedirectMapResourceModel) ([]redirect, error) {
+	bySource := map[string]redirect{}
+	add := func(item redirect) error {
+		if message := redirectSourcePath(item.source); message != "" {
+			return errors.New(message)
+		}
+		if message := validateUrl(item.target); message != "" {
+			return fmt.Errorf("target %s of %s %s", item.target, item.source, message)
+		}
+		if !redirectStatusCode(item.statusCode) {
+			return fmt.Errorf("status code %d of %s must be one of 301, 302, 307 or 308", item.statusCode, item.source)
+		}
+		if existing, ok := bySource[item.source]; ok && existing != item {
+			return fmt.Errorf("source path %s is redirected more than once", item.source)
+		}
+		bySource[item.source] = item
+		return nil
+	}
+
+	for source, target := range data.Redirects.Elements() {
+		target, ok := target.(types.String)
+		if !ok {
+			continue
+		}
+		err := add(redirect{source: source, target: target.ValueString(), statusCode: data.StatusCode.ValueInt64()})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !data.CsvFile.IsNull() {
+		file, err := os.Open(data.CsvFile.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		reader := csv.NewReader(file)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		for line := 1; ; line++ {
+			record, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "source") {
+				continue
+			}
+			if len(record) < 2 || len(record) > 3 {
+				return nil, fmt.Errorf("line %d of %s must have 2 or 3 columns", line, data.CsvFile.ValueString())
+			}
+
+			item := redirect{source: strings.TrimSpace(record[0]), target: strings.TrimSpace(record[1]), statusCode: data.StatusCode.ValueInt64()}
+			if len(record) == 3 && strings.TrimSpace(record[2]) != "" {
+				item.statusCode, err = strconv.ParseInt(strings.TrimSpace(record[2]), 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %d of %s has an invalid status code: %s", line, data.CsvFile.ValueString(), record[2])
+				}
+			}
+			err = add(item)
+			if err != nil {
+				return nil, fmt.Errorf("line %d of %s: %s", line, data.CsvFile.ValueString(), err)
+			}
+		}
+	}
+
+	redirects := []redirect{}
+	for _, item := range bySource {
+		redirects = append(redirects, item)
+	}
+	sort.Slice(redirects, func(i, j int) bool { return redirects[i].source < redirects[j].source })
+	return redirects, nil
+}
+
+// compileRedirectMap groups the redirects by target and status code and packs
+// each group into as few edge rules as the trigger limits allow.
+func compileRedirectMap(name string, hostname string, redirects []redirect) []bunnycdn_api.EdgeRule {
+	type group struct {
+		target     string
+		statusCode int64
+	}
+
+	patterns := map[group][]string{}
+	groups := []group{}
+	for _, item := range redirects {
+		key := group{target: item.target, statusCode: item.statusCode}
+		if _, ok := patterns[key]; !ok {
+			groups = append(groups, key)
+		}
+		// the url trigger matches the full URL, so only the scheme is a wildcard
+		patterns[key] = append(patterns[key], "*://"+hostname+item.source)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].target != groups[j].target {
+			return groups[i].target < groups[j].target
+		}
+		return groups[i].statusCode < groups[j].statusCode
+	})
+
+	edgeRules := []bunnycdn_api.EdgeRule{}
+	for _, key := range groups {
+		triggers := []bunnycdn_api.EdgeRuleTrigger{}
+		matches := patterns[key]
+		for start := 0; start < len(matches); start += bunnycdn_api.EdgeRuleMaxPatternMatches {
+			end := start + bunnycdn_api.EdgeRuleMaxPatternMatches
+			if end > len(matches) {
+				end = len(matches)
+			}
+			triggers = append(triggers, bunnycdn_api.EdgeRuleTrigger{
+				Type:           bunnycdn_api.EdgeRuleTriggerTypes["url"],
+				PatternMatches: matches[start:end],
+			})
+		}
+
+		for start := 0; start < len(triggers); start += bunnycdn_api.EdgeRuleMaxTriggers {
+			end := start + bunnycdn_api.EdgeRuleMaxTriggers
+			if end > len(triggers) {
+				end = len(triggers)
+			}
+			target := key.target
+			statusCode := strconv.FormatInt(key.statusCode, 10)
+			edgeRules = append(edgeRules, bunnycdn_api.EdgeRule{
+				ActionType:       bunnycdn_api.EdgeRuleActionTypes["redirect"],
+				ActionParameter1: &target,
+				ActionParameter2: &statusCode,
+				Triggers:         triggers[start:end],
+				Enabled:          true,
+			})
+		}
+	}
+
+	for i := range edgeRules {
+		description := fmt.Sprintf("Redirect map %s (%d/%d)", name, i+1, len(edgeRules))
+		edgeRules[i].Description = &description
+	}
+	return edgeRules
+}
+
+// redirectMapChecksum returns the checksum of the edge rules. They are
+// normalized first, so compiled edge rules and the ones read from the pull
+// zone have the same checksum.
+func redirectMapChecksum(edgeRules []bunnycdn_api.EdgeRule) string {
+	normalized := []bunnycdn_api.EdgeRule{}
+	for _, item := range edgeRules {
+		normalized = append(normalized, bunnycdn_api.EdgeRuleNormalize(item))
+	}
+	content, _ := json.Marshal(normalized)
+	return fmt.Sprintf("%x", sha256.Sum256(content))
+}
+
+func (r *RedirectMapResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, state model.RedirectMapResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Redirects.IsUnknown() || data.CsvFile.IsUnknown() || data.StatusCode.IsUnknown() || data.Hostname.IsUnknown() || data.Name.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rule_count"), types.Int64Unknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("checksum"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rule_ids"), types.ListUnknown(types.StringType))...)
+		return
+	}
+
+	redirects, err := redirectMapRedirects(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("Invalid redirect map: %s", err))
+		return
+	}
+
+	edgeRules := compileRedirectMap(data.Name.ValueString(), data.Hostname.ValueString(), redirects)
+	checksum := redirectMapChecksum(edgeRules)
+
+	ruleIds := types.ListUnknown(types.StringType)
+	if !req.State.Raw.IsNull() && state.Checksum.ValueString() == checksum {
+		ruleIds = state.RuleIds
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rule_count"), int64(len(edgeRules)))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("checksum"), checksum)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rule_ids"), ruleIds)...)
+}
+
+// apply writes the compiled edge rules of data to the pull zone. The edge
+// rules in previous are updated in place, surplus ones are deleted.
+func (r *RedirectMapResource) apply(ctx context.Context, data *model.RedirectMapResourceModel, previous []string, diagnostics *diag.Diagnostics) {
+	pullzoneId := data.PullzoneId.ValueInt64()
+
+	redirects, err := redirectMapRedirects(*data)
+	if err != nil {
+		diagnostics.AddError("Validation Error", fmt.Sprintf("Invalid redirect map: %s", err))
+		return
+	}
+	edgeRules := compileRedirectMap(data.Name.ValueString(), data.Hostname.ValueString(), redirects)
+
+	remote, err := r.api.EdgeRuleList(ctx, pullzoneId)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read edge rules, got error: %s", err))
+		return
+	}
+	existing := map[string]bool{}
+	for _, item := range remote {
+		existing[item.Guid] = true
+	}
+
+	ruleIds := []string{}
+	// edge rules that are not written yet stay tracked and the checksum is
+	// cleared, so the next plan writes them again
+	failed := func(from int) {
+		if from < len(previous) {
+			ruleIds = append(ruleIds, previous[from:]...)
+		}
+		data.Checksum = types.StringNull()
+		data.RuleCount = types.Int64Value(int64(len(ruleIds)))
+		r.setRuleIds(data, ruleIds)
+	}
+
+	for i, edgeRule := range edgeRules {
+		if i < len(previous) && existing[previous[i]] {
+			edgeRule.Guid = previous[i]
+		}
+		updatedResource, err := r.api.EdgeRuleAddOrUpdate(ctx, pullzoneId, edgeRule)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to write edge rule %s, got error: %s", *edgeRule.Description, err))
+			failed(i)
+			return
+		}
+		ruleIds = append(ruleIds, updatedResource.Guid)
+	}
+
+	for i := len(edgeRules); i < len(previous); i++ {
+		err := r.api.EdgeRuleDelete(ctx, pullzoneId, previous[i])
+		if edgeRuleError, ok := err.(*model.EdgeRuleError); ok && edgeRuleError.StatusCode == 404 {
+			err = nil
+		}
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete edge rule %s, got error: %s", previous[i], err))
+			failed(i)
+			return
+		}
+	}
+
+	data.RuleCount = types.Int64Value(int64(len(edgeRules)))
+	data.Checksum = types.StringValue(redirectMapChecksum(edgeRules))
+	r.setRuleIds(data, ruleIds)
+}
+
+// setRuleIds sets the rule_ids and id of data.
+func (r *RedirectMapResource) setRuleIds(data *model.RedirectMapResourceModel, ruleIds []string) {
+	elements := []attr.Value{}
+	for _, item := range ruleIds {
+		elements = append(elements, types.StringValue(item))
+	}
+	data.RuleIds = types.ListValueMust(types.StringType, elements)
+	data.Id = types.StringValue(fmt.Sprintf("%d/%s", data.PullzoneId.ValueInt64(), data.Name.ValueString()))
+}
+
+func redirectMapRuleIds(data model.RedirectMapResourceModel) []string {
+	ruleIds := []string{}
+	for _, item := range data.RuleIds.Elements() {
+		if item, ok := item.(types.String); ok {
+			ruleIds = append(ruleIds, item.ValueString())
+		}
+	}
+	return ruleIds
+}
+
+func (r *RedirectMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.RedirectMapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, nil, &resp.Diagnostics)
+	if data.RuleIds.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RedirectMapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.RedirectMapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote, err := r.api.EdgeRuleList(ctx, data.PullzoneId.ValueInt64())
+	if err != nil {
+		pullzoneError, ok := err.(*model.PullzoneError)
+		if ok && pullzoneError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read edge rules, got error: %s", err))
+		return
+	}
+	existing := map[string]bunnycdn_api.EdgeRule{}
+	for _, item := range remote {
+		existing[item.Guid] = item
+	}
+
+	// edge rules deleted or changed outside of terraform are written again on
+	// the next apply
+	ruleIds := []string{}
+	edgeRules := []bunnycdn_api.EdgeRule{}
+	for _, item := range redirectMapRuleIds(data) {
+		if edgeRule, ok := existing[item]; ok {
+			ruleIds = append(ruleIds, item)
+			edgeRules = append(edgeRules, edgeRule)
+		}
+	}
+	if len(ruleIds) != len(data.RuleIds.Elements()) || redirectMapChecksum(edgeRules) != data.Checksum.ValueString() {
+		data.Checksum = types.StringNull()
+	}
+
+	r.setRuleIds(&data, ruleIds)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RedirectMapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state model.RedirectMapResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, redirectMapRuleIds(state), &resp.Diagnostics)
+	if data.RuleIds.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RedirectMapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.RedirectMapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, item := range redirectMapRuleIds(data) {
+		err := r.api.EdgeRuleDelete(ctx, data.PullzoneId.ValueInt64(), item)
+		if err != nil {
+			edgeRuleError, ok := err.(*model.EdgeRuleError)
+			if ok && edgeRuleError.StatusCode == 404 {
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete edge rule %s, got error: %s", item, err))
+		}
+	}
+}
@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &StorageDirectoryResource{}
+var _ resource.ResourceWithValidateConfig = &StorageDirectoryResource{}
+var _ resource.ResourceWithModifyPlan = &StorageDirectoryResource{}
+
+func NewStorageDirectoryResource() resource.Resource {
+	return &StorageDirectoryResource{}
+}
+
+type StorageDirectoryResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (r *StorageDirectoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_directory"
+}
+
+func (r *StorageDirectoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Syncs a local directory to a storage zone. The plan lists the SHA256 checksum of every file in `files`, " +
+			"so it shows which files are uploaded and which are deleted. Only changed files are uploaded, files that were " +
+			"removed locally are deleted from the storage zone and other files under `prefix` are left alone.",
+
+		Attributes: map[string]schema.Attribute{
+			"storagezone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the storage zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "The path of the local directory that is uploaded",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "The directory of the storage zone the files are uploaded to. Defaults to the root of the storage zone",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of files that are uploaded or deleted at the same time",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(8),
+				PlanModifiers:       []planmodifier.Int64{},
+			},
+			"files": schema.MapAttribute{
+				MarkdownDescription: "The uppercase hex encoded SHA256 checksums of the synced files, keyed by their path relative to `source`",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Map{},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the directory in the form `<storagezone_id>/<prefix>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *StorageDirectoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *StorageDirectoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.StorageDirectoryResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Concurrency.IsNull() && !data.Concurrency.IsUnknown() && (data.Concurrency.ValueInt64() < 1 || data.Concurrency.ValueInt64() > 64) {
+		resp.Diagnostics.AddAttributeError(path.Root("concurrency"), "Validation Error", "concurrency must be between 1 and 64")
+	}
+}
+
+// storageDirectoryManifest returns the checksums of the regular files below
+// source keyed by their slash separated path relative to source.
+func storageDirectoryManifest(source string) (map[string]string, error) {
+	manifest := map[string]string{}
+
+	err := filepath.WalkDir(source, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		relativePath, err := filepath.Rel(source, filePath)
+		if err != nil {
+			return err
+		}
+		manifest[filepath.ToSlash(relativePath)] = bunnycdn_api.StorageObjectChecksum(content)
+		return nil
+	})
+
+	return manifest, err
+}
+
+// storageDirectoryObjectPath returns the path in the storage zone of the file
+// relativePath of the directory data.
+func storageDirectoryObjectPath(data model.StorageDirectoryResourceModel, relativePath string) string {
+	prefix := strings.Trim(data.Prefix.ValueString(), "/")
+	if prefix == "" {
+		return relativePath
+	}
+	return prefix + "/" + relativePath
+}
+
+func filesToMap(files map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for key, value := range files {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func mapToFiles(value types.Map) map[string]string {
+	files := map[string]string{}
+	for key, item := range value.Elements() {
+		if item, ok := item.(types.String); ok {
+			files[key] = item.ValueString()
+		}
+	}
+	return files
+}
+
+func (r *StorageDirectoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data model.StorageDirectoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Source.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("files"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	manifest, err := storageDirectoryManifest(data.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Source Error", fmt.Sprintf("Unable to read %s, got error: %s", data.Source.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("files"), filesToMap(manifest))...)
+}
+
+// sync uploads the files of data that differ from previous and deletes the
+// files of previous that are no longer in data. The files of data are updated
+// to what was actually synced, so failed files are retried on the next apply.
+// It returns false when nothing was synced.
+func (r *StorageDirectoryResource) sync(ctx context.Context, data *model.StorageDirectoryResourceModel, previous map[string]string, diagnostics *diag.Diagnostics) bool {
+	storagezone, err := r.api.StoragezoneGet(ctx, data.StoragezoneId.ValueInt64())
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage zone, got error: %s", err))
+		return false
+	}
+
+	planned := mapToFiles(data.Files)
+	files := mapToFiles(data.Files)
+
+	uploads := []string{}
+	for relativePath, checksum := range planned {
+		if previous[relativePath] != checksum {
+			uploads = append(uploads, relativePath)
+		}
+	}
+	deletes := []string{}
+	for relativePath := range previous {
+		if _, ok := planned[relativePath]; !ok {
+			deletes = append(deletes, relativePath)
+		}
+	}
+	sort.Strings(uploads)
+	sort.Strings(deletes)
+
+	var mutex sync.Mutex
+	var wait sync.WaitGroup
+	failed := func(relativePath string, message string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		diagnostics.AddError("Client Error", message)
+		if checksum, ok := previous[relativePath]; ok {
+			files[relativePath] = checksum
+		} else {
+			delete(files, relativePath)
+		}
+	}
+
+	slots := make(chan struct{}, data.Concurrency.ValueInt64())
+	run := func(relativePath string, operation func(relativePath string)) {
+		wait.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wait.Done()
+			defer func() { <-slots }()
+			operation(relativePath)
+		}()
+	}
+
+	for _, relativePath := range uploads {
+		run(relativePath, func(relativePath string) {
+			content, err := os.ReadFile(filepath.Join(data.Source.ValueString(), filepath.FromSlash(relativePath)))
+			if err == nil && bunnycdn_api.StorageObjectChecksum(content) != planned[relativePath] {
+				err = fmt.Errorf("the file changed after the plan was made")
+			}
+			if err == nil {
+				err = r.api.StorageObjectUpload(ctx, storagezone, storageDirectoryObjectPath(*data, relativePath), content)
+			}
+			if err != nil {
+				failed(relativePath, fmt.Sprintf("Unable to upload %s, got error: %s", relativePath, err))
+			}
+		})
+	}
+	for _, relativePath := range deletes {
+		run(relativePath, func(relativePath string) {
+			err := r.api.StorageObjectDelete(ctx, storagezone, storageDirectoryObjectPath(*data, relativePath))
+			if storageObjectError, ok := err.(*model.StorageObjectError); ok && storageObjectError.StatusCode == 404 {
+				err = nil
+			}
+			if err != nil {
+				failed(relativePath, fmt.Sprintf("Unable to delete %s, got error: %s", relativePath, err))
+			}
+		})
+	}
+	wait.Wait()
+
+	data.Id = types.StringValue(fmt.Sprintf("%d/%s", data.StoragezoneId.ValueInt64(), strings.Trim(data.Prefix.ValueString(), "/")))
+	data.Files = filesToMap(files)
+	return true
+}
+
+// listFiles returns the checksums of all files below directoryPath of the
+// storage zone keyed by their path relative to directoryPath.
+func (r *StorageDirectoryResource) listFiles(ctx context.Context, storagezone *bunnycdn_api.Storagezone, directoryPath string, relativePath string, files map[string]string) error {
+	objects, err := r.api.StorageObjectList(ctx, storagezone, directoryPath)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range objects {
+		itemPath := item.ObjectName
+		if relativePath != "" {
+			itemPath = relativePath + "/" + item.ObjectName
+		}
+		if item.IsDirectory {
+			err := r.listFiles(ctx, storagezone, strings.Trim(directoryPath+"/"+item.ObjectName, "/"), itemPath, files)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		files[itemPath] = strings.ToUpper(item.Checksum)
+	}
+	return nil
+}
+
+func (r *StorageDirectoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.StorageDirectoryResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.sync(ctx, &data, nil, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageDirectoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.StorageDirectoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storagezone, err := r.api.StoragezoneGet(ctx, data.StoragezoneId.ValueInt64())
+	if err != nil {
+		storagezoneError, ok := err.(*model.StoragezoneError)
+		if ok && storagezoneError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage zone, got error: %s", err))
+		return
+	}
+
+	remote := map[string]string{}
+	err = r.listFiles(ctx, storagezone, strings.Trim(data.Prefix.ValueString(), "/"), "", remote)
+	if storageObjectError, ok := err.(*model.StorageObjectError); ok && storageObjectError.StatusCode == 404 {
+		err = nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list storage objects, got error: %s", err))
+		return
+	}
+
+	// only synced files are tracked, files that changed or disappeared remotely are uploaded again
+	files := map[string]string{}
+	for relativePath := range mapToFiles(data.Files) {
+		if checksum, ok := remote[relativePath]; ok {
+			files[relativePath] = checksum
+		}
+	}
+
+	data.Files = filesToMap(files)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageDirectoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state model.StorageDirectoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.sync(ctx, &data, mapToFiles(state.Files), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageDirectoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.StorageDirectoryResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storagezone, err := r.api.StoragezoneGet(ctx, data.StoragezoneId.ValueInt64())
+	if err != nil {
+		storagezoneError, ok := err.(*model.StoragezoneError)
+		if ok && storagezoneError.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage zone, got error: %s", err))
+		return
+	}
+
+	for relativePath := range mapToFiles(data.Files) {
+		err := r.api.StorageObjectDelete(ctx, storagezone, storageDirectoryObjectPath(data, relativePath))
+		if storageObjectError, ok := err.(*model.StorageObjectError); ok && storageObjectError.StatusCode == 404 {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s, got error: %s", relativePath, err))
+		}
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &StorageObjectDataSource{}
+
+// storageObjectDefaultMaxSize keeps files that are read into the state at 1 MiB
+// unless max_size says otherwise.
+const storageObjectDefaultMaxSize = 1024 * 1024
+
+func NewStorageObjectDataSource() datasource.DataSource {
+	return &StorageObjectDataSource{}
+}
+
+type StorageObjectDataSource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (d *StorageObjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_object"
+}
+
+func (d *StorageObjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a file from a storage zone, or lists a directory when `path` ends with `/`. " +
+			"Files larger than `max_size` are rejected to keep them out of the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"storagezone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the storage zone",
+				Required:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the file in the storage zone, or of a directory ending with `/`. `/` lists the root of the storage zone",
+				Required:            true,
+			},
+			"max_size": schema.Int64Attribute{
+				MarkdownDescription: "The maximum size of the file in bytes. Defaults to 1048576 (1 MiB)",
+				Optional:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The content of the file. Null when the file is not valid UTF-8",
+				Computed:            true,
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "The content of the file, base64 encoded",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "The size of the file in bytes",
+				Computed:            true,
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "The uppercase hex encoded SHA256 checksum of the file",
+				Computed:            true,
+			},
+			"content_type": schema.StringAttribute{
+				MarkdownDescription: "The content type of the file",
+				Computed:            true,
+			},
+			"last_changed": schema.StringAttribute{
+				MarkdownDescription: "The time the file was last changed",
+				Computed:            true,
+			},
+			"objects": schema.ListNestedAttribute{
+				MarkdownDescription: "The files and directories of the directory, null when `path` is a file",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the file or directory",
+							Computed:            true,
+						},
+						"is_directory": schema.BoolAttribute{
+							MarkdownDescription: "Whether the object is a directory",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "The size of the file in bytes",
+							Computed:            true,
+						},
+						"checksum": schema.StringAttribute{
+							MarkdownDescription: "The uppercase hex encoded SHA256 checksum of the file",
+							Computed:            true,
+						},
+						"content_type": schema.StringAttribute{
+							MarkdownDescription: "The content type of the file",
+							Computed:            true,
+						},
+						"last_changed": schema.StringAttribute{
+							MarkdownDescription: "The time the object was last changed",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StorageObjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.api = api
+}
+
+func (d *StorageObjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.StorageObjectDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storagezone, err := d.api.StoragezoneGet(ctx, data.StoragezoneId.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage zone, got error: %s", err))
+		return
+	}
+
+	data.Content = types.StringNull()
+	data.ContentBase64 = types.StringNull()
+	data.Size = types.Int64Null()
+	data.Checksum = types.StringNull()
+	data.ContentType = types.StringNull()
+	data.LastChanged = types.StringNull()
+	data.Objects = nil
+
+	if strings.HasSuffix(data.Path.ValueString(), "/") {
+		objects, err := d.api.StorageObjectList(ctx, storagezone, data.Path.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list storage objects, got error: %s", err))
+			return
+		}
+
+		data.Objects = []model.StorageObjectDataSourceObjectModel{}
+		for _, item := range objects {
+			data.Objects = append(data.Objects, bunnycdn_api.StorageObjectToStorageObjectDataSourceObjectModel(item))
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	remoteResource, err := d.api.StorageObjectGet(ctx, storagezone, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage object, got error: %s", err))
+		return
+	}
+
+	maxSize := int64(storageObjectDefaultMaxSize)
+	if !data.MaxSize.IsNull() {
+		maxSize = data.MaxSize.ValueInt64()
+	}
+	if remoteResource.Length > maxSize {
+		resp.Diagnostics.AddError(
+			"Storage Object Too Large",
+			fmt.Sprintf("%s has %d bytes, which is more than max_size (%d bytes). Raise max_size to read it into the state.", data.Path.ValueString(), remoteResource.Length, maxSize),
+		)
+		return
+	}
+
+	content, err := d.api.StorageObjectDownload(ctx, storagezone, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to download storage object, got error: %s", err))
+		return
+	}
+
+	object := bunnycdn_api.StorageObjectToStorageObjectDataSourceObjectModel(*remoteResource)
+	if utf8.Valid(content) {
+		data.Content = types.StringValue(string(content))
+	}
+	data.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(content))
+	data.Size = types.Int64Value(int64(len(content)))
+	data.Checksum = types.StringValue(bunnycdn_api.StorageObjectChecksum(content))
+	data.ContentType = object.ContentType
+	data.LastChanged = object.LastChanged
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &StorageObjectResource{}
+var _ resource.ResourceWithImportState = &StorageObjectResource{}
+var _ resource.ResourceWithValidateConfig = &StorageObjectResource{}
+var _ resource.ResourceWithModifyPlan = &StorageObjectResource{}
+
+func NewStorageObjectResource() resource.Resource {
+	return &StorageObjectResource{}
+}
+
+type StorageObjectResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (r *StorageObjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_object"
+}
+
+func (r *StorageObjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "File in a storage zone, uploaded through the storage API with the password of the storage zone. " +
+			"Exactly one of `content`, `content_base64` or `source` must be set. Changes made outside of terraform are " +
+			"detected through the SHA256 `checksum` of the file.",
+
+		Attributes: map[string]schema.Attribute{
+			"storagezone_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the storage zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the file in the storage zone, e.g. `robots.txt` or `maintenance/index.html`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The content of the file as UTF-8 text",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "The content of the file, base64 encoded. Use it for binary files",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "The path of a local file that is uploaded",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "The uppercase hex encoded SHA256 checksum of the file. It is sent along with the upload, so bunny.net rejects corrupted uploads",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the file in the form `<storagezone_id>/<path>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *StorageObjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *StorageObjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model.StorageObjectResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set := 0
+	for _, value := range []types.String{data.Content, data.ContentBase64, data.Source} {
+		if !value.IsNull() {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddError("Validation Error", "exactly one of content, content_base64 or source must be set")
+		return
+	}
+
+	if !data.ContentBase64.IsNull() && !data.ContentBase64.IsUnknown() {
+		_, err := base64.StdEncoding.DecodeString(data.ContentBase64.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("content_base64"), "Validation Error", fmt.Sprintf("content_base64 is not valid base64: %s", err))
+		}
+	}
+
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
+		objectPath := strings.Trim(data.Path.ValueString(), "/")
+		if objectPath == "" || objectPath != data.Path.ValueString() {
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "Validation Error", "path must not be empty and must not start or end with /")
+		}
+	}
+}
+
+// storageObjectContent returns the content of the file described by data.
+func storageObjectContent(data model.StorageObjectResourceModel) ([]byte, error) {
+	if !data.ContentBase64.IsNull() {
+		return base64.StdEncoding.DecodeString(data.ContentBase64.ValueString())
+	}
+	if !data.Source.IsNull() {
+		return os.ReadFile(data.Source.ValueString())
+	}
+	return []byte(data.Content.ValueString()), nil
+}
+
+func (r *StorageObjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data model.StorageObjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the checksum of the local content decides whether the file is uploaded again,
+	// so edits of a source file are picked up although its path stays the same
+	if data.Content.IsUnknown() || data.ContentBase64.IsUnknown() || data.Source.IsUnknown() {
+		data.Checksum = types.StringUnknown()
+	} else {
+		content, err := storageObjectContent(data)
+		if err != nil {
+			resp.Diagnostics.AddError("Content Error", fmt.Sprintf("Unable to read the content of %s, got error: %s", data.Path.ValueString(), err))
+			return
+		}
+		data.Checksum = types.StringValue(bunnycdn_api.StorageObjectChecksum(content))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("checksum"), data.Checksum)...)
+}
+
+// upload uploads the content of data to its storage zone and sets the id and
+// checksum of data.
+func (r *StorageObjectResource) upload(ctx context.Context, data *model.StorageObjectResourceModel) error {
+	content, err := storageObjectContent(*data)
+	if err != nil {
+		return err
+	}
+
+	storagezone, err := r.api.StoragezoneGet(ctx, data.StoragezoneId.ValueInt64())
+	if err != nil {
+		return err
+	}
+
+	err = r.api.StorageObjectUpload(ctx, storagezone, data.Path.ValueString(), content)
+	if err != nil {
+		return err
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%d/%s", data.StoragezoneId.ValueInt64(), data.Path.ValueString()))
+	data.Checksum = types.StringValue(bunnycdn_api.StorageObjectChecksum(content))
+	return nil
+}
+
+func (r *StorageObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.StorageObjectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.upload(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload storage object, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.StorageObjectResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storagezone, err := r.api.StoragezoneGet(ctx, data.StoragezoneId.ValueInt64())
+	if err != nil {
+		storagezoneError, ok := err.(*model.StoragezoneError)
+		if ok && storagezoneError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage zone, got error: %s", err))
+		return
+	}
+
+	remoteResource, err := r.api.StorageObjectGet(ctx, storagezone, data.Path.ValueString())
+	if err != nil {
+		storageObjectError, ok := err.(*model.StorageObjectError)
+		if ok && storageObjectError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage object, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%d/%s", data.StoragezoneId.ValueInt64(), data.Path.ValueString()))
+	data.Checksum = types.StringValue(strings.ToUpper(remoteResource.Checksum))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data model.StorageObjectResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.upload(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload storage object, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.StorageObjectResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storagezone, err := r.api.StoragezoneGet(ctx, data.StoragezoneId.ValueInt64())
+	if err != nil {
+		storagezoneError, ok := err.(*model.StoragezoneError)
+		if ok && storagezoneError.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage zone, got error: %s", err))
+		return
+	}
+
+	err = r.api.StorageObjectDelete(ctx, storagezone, data.Path.ValueString())
+	if err != nil {
+		storageObjectError, ok := err.(*model.StorageObjectError)
+		if ok && storageObjectError.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete storage object, got error: %s", err))
+		return
+	}
+}
+
+func (r *StorageObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected <storagezone_id>/<path>, got: %s", req.ID))
+		return
+	}
+
+	storagezoneId, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected a storage zone ID, got: %s", parts[0]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("storagezone_id"), storagezoneId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), parts[1])...)
+}
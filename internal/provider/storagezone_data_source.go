@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &StoragezoneDataSource{}
+
+func NewStoragezoneDataSource() datasource.DataSource {
+	return &StoragezoneDataSource{}
+}
+
+type StoragezoneDataSource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (d *StoragezoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storagezone"
+}
+
+func (d *StoragezoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Storage zone data source. Looks up a storage zone by `id` or by exact `name`. " +
+			"The passwords of the storage zone are not exposed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the storage zone",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the storage zone",
+				Optional:            true,
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The code of the main storage region",
+				Computed:            true,
+			},
+			"replication_regions": schema.SetAttribute{
+				MarkdownDescription: "The codes of the regions the storage zone is replicated to",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"zone_tier": schema.Int64Attribute{
+				MarkdownDescription: "The tier of the storage zone (0 = Standard, 1 = Edge)",
+				Computed:            true,
+			},
+			"storage_hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the storage API endpoint of the storage zone",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *StoragezoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.api = api
+}
+
+func (d *StoragezoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.StoragezoneDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddError("Validation Error", "exactly one of id or name must be set")
+		return
+	}
+
+	id := data.Id.ValueInt64()
+	if data.Id.IsNull() {
+		storagezones, err := d.api.StoragezoneList(ctx, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list storage zones, got error: %s", err))
+			return
+		}
+
+		found := false
+		for _, item := range storagezones {
+			if item.Name == data.Name.ValueString() {
+				id = item.Id
+				found = true
+				break
+			}
+		}
+		if !found {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Storage zone with name %s does not exist", data.Name.ValueString()))
+			return
+		}
+	}
+
+	remoteResource, err := d.api.StoragezoneGet(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage zone, got error: %s", err))
+		return
+	}
+
+	data = bunnycdn_api.StoragezoneToStoragezoneDataSourceModel(remoteResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
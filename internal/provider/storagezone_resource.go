@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"terraform-provider-bunnycdn/internal/bunnycdn_api"
+	"terraform-provider-bunnycdn/internal/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &StoragezoneResource{}
+var _ resource.ResourceWithImportState = &StoragezoneResource{}
+var _ resource.ResourceWithModifyPlan = &StoragezoneResource{}
+
+func NewStoragezoneResource() resource.Resource {
+	return &StoragezoneResource{}
+}
+
+type StoragezoneResource struct {
+	api *bunnycdn_api.BunnycdnApi
+}
+
+func (r *StoragezoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storagezone"
+}
+
+func (r *StoragezoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Storage zone resource",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the storage zone. Changing it creates a new storage zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The code of the main storage region, e.g. `DE`, `NY` or `SG`. Changing it creates a new storage zone",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("DE"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"replication_regions": schema.SetAttribute{
+				MarkdownDescription: "The codes of the regions the storage zone is replicated to. Regions can only be added, " +
+					"removing a region replaces the storage zone and deletes all files stored in it",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"zone_tier": schema.Int64Attribute{
+				MarkdownDescription: "The tier of the storage zone (0 = Standard, 1 = Edge). Changing it creates a new storage zone",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"custom_404_file_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the file that is returned when a file is not found",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{},
+			},
+			"rewrite_404_to_200": schema.BoolAttribute{
+				MarkdownDescription: "Whether a not found file is returned with status code 200 instead of 404",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers:       []planmodifier.Bool{},
+			},
+			"storage_hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the storage API endpoint of the storage zone",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The read-write password of the storage zone, used as `AccessKey` of the storage API and FTP",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"read_only_password": schema.StringAttribute{
+				MarkdownDescription: "The read-only password of the storage zone",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the storage zone",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *StoragezoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*bunnycdn_api.BunnycdnApi)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected bunnycdn_api.BunnycdnApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = api
+}
+
+func (r *StoragezoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data, state model.StoragezoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() || data.ReplicationRegions.IsUnknown() {
+		return
+	}
+
+	replicationRegions := map[string]bool{}
+	for _, region := range bunnycdn_api.SetToStrings(data.ReplicationRegions) {
+		replicationRegions[region] = true
+	}
+
+	// bunny.net can only add replication regions, a removed region needs a new storage zone
+	if !req.State.Raw.IsNull() {
+		removed := []string{}
+		for _, region := range bunnycdn_api.SetToStrings(state.ReplicationRegions) {
+			if !replicationRegions[region] {
+				removed = append(removed, region)
+			}
+		}
+		if len(removed) > 0 {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("replication_regions"))
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("replication_regions"),
+				"Storage zone will be replaced",
+				fmt.Sprintf("bunny.net does not support removing replication regions, removing %s destroys and recreates "+
+					"the storage zone. All files stored in it are deleted and new passwords are assigned.", strings.Join(removed, ", ")),
+			)
+		}
+	}
+
+	if !data.Region.IsUnknown() && replicationRegions[data.Region.ValueString()] {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("replication_regions"),
+			"Invalid replication region",
+			fmt.Sprintf("The main region %s must not be a replication region.", data.Region.ValueString()),
+		)
+	}
+
+	if r.api == nil {
+		return
+	}
+
+	regions, err := r.api.StoragezoneRegionList(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to list regions, region codes are not checked, got error: %s", err))
+		return
+	}
+
+	known := map[string]bool{}
+	codes := []string{}
+	for _, region := range regions {
+		if region.RegionCode != "" && !known[region.RegionCode] {
+			known[region.RegionCode] = true
+			codes = append(codes, region.RegionCode)
+		}
+	}
+	sort.Strings(codes)
+
+	if !data.Region.IsUnknown() && !known[data.Region.ValueString()] {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unknown region",
+			fmt.Sprintf("The region %s does not exist, expected one of: %s", data.Region.ValueString(), strings.Join(codes, ", ")),
+		)
+	}
+	for _, region := range bunnycdn_api.SetToStrings(data.ReplicationRegions) {
+		if !known[region] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("replication_regions"),
+				"Unknown region",
+				fmt.Sprintf("The replication region %s does not exist, expected one of: %s", region, strings.Join(codes, ", ")),
+			)
+		}
+	}
+}
+
+func (r *StoragezoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model.StoragezoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storagezone := bunnycdn_api.StoragezoneResourceModelToStoragezone(data)
+	createdResource, err := r.api.StoragezoneCreate(ctx, storagezone)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create storage zone, got error: %s", err))
+		return
+	}
+
+	// the 404 settings can only be set by an update
+	storagezone.Id = createdResource.Id
+	if storagezone.Custom404FilePath != nil || storagezone.Rewrite404To200 {
+		err = r.api.StoragezoneUpdate(ctx, storagezone)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update storage zone, got error: %s", err))
+			return
+		}
+	}
+
+	remoteResource, err := r.api.StoragezoneGet(ctx, createdResource.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage zone, got error: %s", err))
+		return
+	}
+
+	data = bunnycdn_api.StoragezoneToStoragezoneResourceModel(remoteResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StoragezoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model.StoragezoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteResource, err := r.api.StoragezoneGet(ctx, data.Id.ValueInt64())
+	if err != nil {
+		storagezoneError, ok := err.(*model.StoragezoneError)
+		if ok && storagezoneError.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage zone, got error: %s", err))
+		return
+	}
+
+	data = bunnycdn_api.StoragezoneToStoragezoneResourceModel(remoteResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StoragezoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data model.StoragezoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.StoragezoneUpdate(ctx, bunnycdn_api.StoragezoneResourceModelToStoragezone(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update storage zone, got error: %s", err))
+		return
+	}
+
+	remoteResource, err := r.api.StoragezoneGet(ctx, data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read storage zone, got error: %s", err))
+		return
+	}
+
+	data = bunnycdn_api.StoragezoneToStoragezoneResourceModel(remoteResource)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StoragezoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model.StoragezoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.api.StoragezoneDelete(ctx, bunnycdn_api.StoragezoneResourceModelToStoragezone(data))
+	if err != nil {
+		storagezoneError, ok := err.(*model.StoragezoneError)
+		if ok && storagezoneError.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete storage zone, got error: %s", err))
+		return
+	}
+}
+
+func (r *StoragezoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Expected a storage zone ID, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// valueCheck checks a single string value. It returns an error message when
+// value is not accepted.
+type valueCheck func(value string) string
+
+func validateAny(value string) string {
+	return ""
+}
+
+func validateNotEmpty(value string) string {
+	if value == "" {
+		return "must not be empty"
+	}
+	return ""
+}
+
+func validateUrl(value string) string {
+	// variables such as {{path}} are expanded by bunny.net
+	parsed, err := url.Parse(strings.NewReplacer("{{", "", "}}", "").Replace(value))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "must be an absolute http or https URL"
+	}
+	return ""
+}
+
+func validateIntegerBetween(min int64, max int64) valueCheck {
+	return func(value string) string {
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || number < min || number > max {
+			return fmt.Sprintf("must be an integer between %d and %d", min, max)
+		}
+		return ""
+	}
+}
+
+// typeNames returns the names of types for documentation.
+func typeNames(names map[string]int64) string {
+	items := []string{}
+	for name := range names {
+		items = append(items, name)
+	}
+	sort.Strings(items)
+	return "`" + strings.Join(items, "`, `") + "`"
+}
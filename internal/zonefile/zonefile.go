@@ -0,0 +1,541 @@
+package zonefile
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Record is a resource record of a zone file. Name is relative to the origin
+// of the zone and empty for the origin itself. Domain names in Value are
+// absolute, without the trailing dot.
+type Record struct {
+	Line     int
+	Name     string
+	Ttl      int64
+	Type     string
+	Value    string
+	Priority int64
+	Weight   int64
+	Port     int64
+	Flags    int64
+	Tag      string
+}
+
+// String returns the record in zone file presentation format, e.g.
+// "www 3600 CNAME example.com".
+func (r Record) String() string {
+	name := r.Name
+	if name == "" {
+		name = "@"
+	}
+
+	data := r.Value
+	switch r.Type {
+	case "MX":
+		data = fmt.Sprintf("%d %s", r.Priority, r.Value)
+	case "SRV":
+		data = fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, r.Value)
+	case "CAA":
+		data = fmt.Sprintf("%d %s %s", r.Flags, r.Tag, strconv.Quote(r.Value))
+	case "TXT":
+		data = strconv.Quote(r.Value)
+	}
+	return fmt.Sprintf("%s %d %s %s", name, r.Ttl, r.Type, data)
+}
+
+// Key identifies the record by name, type and value, e.g. "www A 192.0.2.1".
+// It leaves out the TTL, priority, weight, port and flags, so records that
+// only differ in those are the same record with different settings.
+func (r Record) Key() string {
+	name := r.Name
+	if name == "" {
+		name = "@"
+	}
+
+	value := r.Value
+	switch r.Type {
+	case "CAA":
+		value = fmt.Sprintf("%s %s", r.Tag, strconv.Quote(r.Value))
+	case "TXT":
+		value = strconv.Quote(r.Value)
+	}
+	return fmt.Sprintf("%s %s %s", name, r.Type, value)
+}
+
+// Unsupported is a construct of a zone file that has no Bunny DNS
+// equivalent and is skipped.
+type Unsupported struct {
+	Line   int
+	Text   string
+	Reason string
+}
+
+func (u Unsupported) String() string {
+	return fmt.Sprintf("line %d: %s: %s", u.Line, u.Text, u.Reason)
+}
+
+type Zone struct {
+	Records     []Record
+	Unsupported []Unsupported
+}
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+// entry is a logical line of a zone file, which spans several lines when it
+// uses parentheses.
+type entry struct {
+	line   int
+	blank  bool
+	tokens []token
+}
+
+func (e entry) String() string {
+	items := []string{}
+	for _, item := range e.tokens {
+		if item.quoted {
+			items = append(items, strconv.Quote(item.text))
+		} else {
+			items = append(items, item.text)
+		}
+	}
+	return strings.Join(items, " ")
+}
+
+// Parse parses an RFC 1035 zone file for the zone origin. ttl is used for
+// records without a TTL when the file has no $TTL directive. Constructs that
+// cannot be represented in Bunny DNS, such as SOA records, other classes,
+// unknown record types and $INCLUDE, are returned as Unsupported. Malformed
+// files return an error.
+func Parse(content string, origin string, ttl int64) (*Zone, error) {
+	entries, err := tokenize(content)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{
+		zone:     &Zone{},
+		zoneName: normalizeName(origin),
+		origin:   normalizeName(origin),
+		ttl:      ttl,
+		seen:     map[string]bool{},
+	}
+	for _, item := range entries {
+		if err := p.parseEntry(item); err != nil {
+			return nil, fmt.Errorf("line %d: %w", item.line, err)
+		}
+	}
+	return p.zone, nil
+}
+
+type parser struct {
+	zone     *Zone
+	zoneName string
+	origin   string
+	owner    string
+	ttl      int64
+	explicit bool
+	lastTtl  int64
+	seen     map[string]bool
+}
+
+func (p *parser) unsupported(item entry, reason string) {
+	p.zone.Unsupported = append(p.zone.Unsupported, Unsupported{
+		Line:   item.line,
+		Text:   item.String(),
+		Reason: reason,
+	})
+}
+
+func (p *parser) parseEntry(item entry) error {
+	tokens := item.tokens
+	if !item.blank && !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+		return p.parseDirective(item)
+	}
+
+	if item.blank {
+		if p.owner == "" {
+			return errors.New("record without owner name")
+		}
+	} else {
+		p.owner = p.absolute(tokens[0].text)
+		tokens = tokens[1:]
+	}
+
+	// the TTL and the class are optional and can be given in any order
+	ttl := int64(-1)
+	for len(tokens) > 0 {
+		if isClass(tokens[0].text) {
+			if !strings.EqualFold(tokens[0].text, "IN") {
+				p.unsupported(item, fmt.Sprintf("class %s is not supported", strings.ToUpper(tokens[0].text)))
+				return nil
+			}
+			tokens = tokens[1:]
+			continue
+		}
+		if ttl >= 0 {
+			break
+		}
+		value, err := parseTtl(tokens[0].text)
+		if err != nil {
+			break
+		}
+		ttl = value
+		tokens = tokens[1:]
+	}
+
+	if len(tokens) == 0 {
+		return errors.New("missing record type")
+	}
+	recordType := strings.ToUpper(tokens[0].text)
+	data := tokens[1:]
+
+	if ttl < 0 {
+		ttl = p.defaultTtl()
+	} else {
+		p.lastTtl = ttl
+	}
+
+	name, ok := p.relative(p.owner)
+	if !ok {
+		p.unsupported(item, fmt.Sprintf("%s is outside of the zone %s", p.owner, p.zoneName))
+		return nil
+	}
+
+	record := Record{
+		Line: item.line,
+		Name: name,
+		Ttl:  ttl,
+		Type: recordType,
+	}
+	reason, err := p.parseData(&record, data)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		p.unsupported(item, reason)
+		return nil
+	}
+
+	key := strings.ToLower(record.Key())
+	if p.seen[key] {
+		p.unsupported(item, "duplicate record")
+		return nil
+	}
+	p.seen[key] = true
+	p.zone.Records = append(p.zone.Records, record)
+	return nil
+}
+
+func (p *parser) parseDirective(item entry) error {
+	directive := strings.ToUpper(item.tokens[0].text)
+	switch directive {
+	case "$ORIGIN":
+		if len(item.tokens) != 2 {
+			return errors.New("$ORIGIN needs a domain name")
+		}
+		p.origin = p.absolute(item.tokens[1].text)
+	case "$TTL":
+		if len(item.tokens) != 2 {
+			return errors.New("$TTL needs a TTL")
+		}
+		value, err := parseTtl(item.tokens[1].text)
+		if err != nil {
+			return err
+		}
+		p.ttl = value
+		p.explicit = true
+	default:
+		p.unsupported(item, fmt.Sprintf("directive %s is not supported", directive))
+	}
+	return nil
+}
+
+// defaultTtl returns the TTL of records without one, the $TTL directive or
+// else the TTL of the previous record as RFC 1035 specifies.
+func (p *parser) defaultTtl() int64 {
+	if !p.explicit && p.lastTtl > 0 {
+		return p.lastTtl
+	}
+	return p.ttl
+}
+
+// parseData parses the record data into record. It returns the reason when
+// the record is not supported.
+func (p *parser) parseData(record *Record, data []token) (string, error) {
+	count := map[string]int{"A": 1, "AAAA": 1, "CNAME": 1, "NS": 1, "PTR": 1, "MX": 2, "SRV": 4, "CAA": 3}
+	if expected, ok := count[record.Type]; ok && len(data) != expected {
+		return "", fmt.Errorf("%s record needs %d fields, got %d", record.Type, expected, len(data))
+	}
+
+	var err error
+	switch record.Type {
+	case "A", "AAAA":
+		ip := net.ParseIP(data[0].text)
+		if ip == nil || (ip.To4() != nil) != (record.Type == "A") {
+			return "", fmt.Errorf("invalid %s record address %s", record.Type, data[0].text)
+		}
+		record.Value = ip.String()
+	case "CNAME", "PTR":
+		record.Value = p.absolute(data[0].text)
+	case "NS":
+		if record.Name == "" {
+			return "the NS records of the zone are managed by bunny.net", nil
+		}
+		record.Value = p.absolute(data[0].text)
+	case "MX":
+		if record.Priority, err = parseUint16(data[0].text); err != nil {
+			return "", err
+		}
+		record.Value = p.absolute(data[1].text)
+	case "SRV":
+		for i, field := range []*int64{&record.Priority, &record.Weight, &record.Port} {
+			if *field, err = parseUint16(data[i].text); err != nil {
+				return "", err
+			}
+		}
+		record.Value = p.absolute(data[3].text)
+	case "CAA":
+		flags, err := strconv.ParseInt(data[0].text, 10, 64)
+		if err != nil || flags < 0 || flags > 255 {
+			return "", fmt.Errorf("invalid CAA flags %s", data[0].text)
+		}
+		record.Flags = flags
+		record.Tag = strings.ToLower(data[1].text)
+		if record.Tag != "issue" && record.Tag != "issuewild" && record.Tag != "iodef" {
+			return fmt.Sprintf("CAA tag %s is not supported", data[1].text), nil
+		}
+		record.Value = data[2].text
+	case "TXT":
+		if len(data) == 0 {
+			return "", errors.New("TXT record needs at least one string")
+		}
+		value := ""
+		for _, item := range data {
+			value += item.text
+		}
+		record.Value = value
+	case "SOA":
+		return "the SOA record of the zone is managed by bunny.net", nil
+	default:
+		return fmt.Sprintf("record type %s is not supported by Bunny DNS", record.Type), nil
+	}
+
+	if record.Value == "" {
+		return fmt.Sprintf("%s record without target is not supported", record.Type), nil
+	}
+	return "", nil
+}
+
+// absolute returns name as an absolute domain name without the trailing dot.
+func (p *parser) absolute(name string) string {
+	if name == "@" {
+		return p.origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return normalizeName(name)
+	}
+	if p.origin == "" {
+		return name
+	}
+	return name + "." + p.origin
+}
+
+// relative returns name relative to the zone, and false when the name is
+// outside of the zone.
+func (p *parser) relative(name string) (string, bool) {
+	if strings.EqualFold(name, p.zoneName) {
+		return "", true
+	}
+	suffix := "." + p.zoneName
+	if len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return name[:len(name)-len(suffix)], true
+	}
+	return "", false
+}
+
+func normalizeName(name string) string {
+	return strings.TrimSuffix(name, ".")
+}
+
+func isClass(value string) bool {
+	switch strings.ToUpper(value) {
+	case "IN", "CH", "CS", "HS":
+		return true
+	}
+	return false
+}
+
+func parseUint16(value string) (int64, error) {
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number < 0 || number > 65535 {
+		return 0, fmt.Errorf("invalid number %s", value)
+	}
+	return number, nil
+}
+
+// parseTtl parses a TTL in seconds or with BIND units, e.g. 1h30m.
+func parseTtl(value string) (int64, error) {
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+	total := int64(0)
+	number := int64(-1)
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= '0' && c <= '9':
+			if number < 0 {
+				number = 0
+			}
+			number = number*10 + int64(c-'0')
+		case units[c|0x20] > 0 && number >= 0:
+			total += number * units[c|0x20]
+			number = -1
+		default:
+			return 0, fmt.Errorf("invalid TTL %s", value)
+		}
+		if number > 1<<31-1 || total > 1<<31-1 {
+			return 0, fmt.Errorf("invalid TTL %s", value)
+		}
+	}
+	if number >= 0 {
+		total += number
+	} else if len(value) == 0 {
+		return 0, fmt.Errorf("invalid TTL %s", value)
+	}
+	if total > 1<<31-1 {
+		return 0, fmt.Errorf("invalid TTL %s", value)
+	}
+	return total, nil
+}
+
+// tokenize splits content into entries, removing comments and joining lines
+// within parentheses.
+func tokenize(content string) ([]entry, error) {
+	entries := []entry{}
+	line := 1
+	current := entry{line: 1}
+	depth := 0
+	startOfLine := true
+
+	var text strings.Builder
+	inToken := false
+	flush := func(quoted bool) {
+		if inToken || quoted {
+			current.tokens = append(current.tokens, token{text: text.String(), quoted: quoted})
+		}
+		text.Reset()
+		inToken = false
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		if startOfLine && depth == 0 {
+			current = entry{line: line, blank: c == ' ' || c == '\t'}
+		}
+		startOfLine = false
+
+		switch c {
+		case ';':
+			flush(false)
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case '"':
+			flush(false)
+			start := line
+			closed := false
+			for i++; i < len(content); i++ {
+				if content[i] == '"' {
+					closed = true
+					break
+				}
+				if content[i] == '\n' {
+					line++
+				}
+				if content[i] == '\\' {
+					escaped, length, err := unescape(content[i+1:])
+					if err != nil {
+						return nil, fmt.Errorf("line %d: %w", line, err)
+					}
+					text.WriteByte(escaped)
+					i += length
+					continue
+				}
+				text.WriteByte(content[i])
+			}
+			if !closed {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", start)
+			}
+			flush(true)
+		case '(':
+			flush(false)
+			depth++
+		case ')':
+			flush(false)
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parenthesis", line)
+			}
+			depth--
+		case ' ', '\t', '\r':
+			flush(false)
+		case '\n':
+			flush(false)
+			line++
+			if depth == 0 {
+				if len(current.tokens) > 0 {
+					entries = append(entries, current)
+				}
+				current = entry{line: line}
+				startOfLine = true
+			}
+		case '\\':
+			escaped, length, err := unescape(content[i+1:])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			text.WriteByte(escaped)
+			inToken = true
+			i += length
+		default:
+			text.WriteByte(c)
+			inToken = true
+		}
+	}
+
+	flush(false)
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parenthesis", current.line)
+	}
+	if len(current.tokens) > 0 {
+		entries = append(entries, current)
+	}
+	return entries, nil
+}
+
+// unescape decodes the escape sequence following a backslash, either \DDD
+// or a single character. It returns the byte and the length of the
+// sequence.
+func unescape(value string) (byte, int, error) {
+	if len(value) == 0 {
+		return 0, 0, errors.New("escape at end of file")
+	}
+	if len(value) >= 3 && isDigit(value[0]) && isDigit(value[1]) && isDigit(value[2]) {
+		number, _ := strconv.Atoi(value[:3])
+		if number > 255 {
+			return 0, 0, fmt.Errorf("invalid escape \\%s", value[:3])
+		}
+		return byte(number), 3, nil
+	}
+	return value[0], 1, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
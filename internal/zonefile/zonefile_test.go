@@ -0,0 +1,267 @@
+package zonefile
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		records     []string
+		unsupported []string
+		err         string
+	}{
+		{
+			name:    "default TTL",
+			content: "www A 192.0.2.1\n",
+			records: []string{"www 3600 A 192.0.2.1"},
+		},
+		{
+			name:    "$TTL and $ORIGIN",
+			content: "$TTL 300\n@ A 192.0.2.1\n$ORIGIN sub.example.com.\nwww A 192.0.2.2\n@ 600 A 192.0.2.3\nmail A 192.0.2.4\n",
+			records: []string{
+				"@ 300 A 192.0.2.1",
+				"www.sub 300 A 192.0.2.2",
+				"sub 600 A 192.0.2.3",
+				"mail.sub 300 A 192.0.2.4",
+			},
+		},
+		{
+			name:    "TTL of the previous record",
+			content: "@ 600 A 192.0.2.1\nwww A 192.0.2.2\n    AAAA 2001:db8::1\n",
+			records: []string{
+				"@ 600 A 192.0.2.1",
+				"www 600 A 192.0.2.2",
+				"www 600 AAAA 2001:db8::1",
+			},
+		},
+		{
+			name:    "BIND TTL units",
+			content: "$TTL 1d\n@ A 192.0.2.1\nwww 1h30m A 192.0.2.2\nmail 2W IN A 192.0.2.3\n",
+			records: []string{
+				"@ 86400 A 192.0.2.1",
+				"www 5400 A 192.0.2.2",
+				"mail 1209600 A 192.0.2.3",
+			},
+		},
+		{
+			name:    "invalid TTL",
+			content: "$TTL 1x\n",
+			err:     "line 1: invalid TTL 1x",
+		},
+		{
+			name:        "names",
+			content:     "@ MX 10 mail\nwww CNAME example.com.\nabs.example.com. A 192.0.2.1\nWWW.Example.COM. AAAA 2001:db8::1\nother.org. A 192.0.2.2\n",
+			records:     []string{"@ 3600 MX 10 mail.example.com", "www 3600 CNAME example.com", "abs 3600 A 192.0.2.1", "WWW 3600 AAAA 2001:db8::1"},
+			unsupported: []string{"line 5: other.org. A 192.0.2.2: other.org is outside of the zone example.com"},
+		},
+		{
+			name:    "parentheses",
+			content: "@ IN MX (\n  10   ; priority\n  mail ; exchange\n)\nwww A 192.0.2.1\n",
+			records: []string{"@ 3600 MX 10 mail.example.com", "www 3600 A 192.0.2.1"},
+		},
+		{
+			name:    "comment in quotes",
+			content: "@ TXT \"v=spf1 ; not a comment\" ; a comment\n",
+			records: []string{`@ 3600 TXT "v=spf1 ; not a comment"`},
+		},
+		{
+			name:    "escapes",
+			content: "@ TXT \"a\\034b\\\\c\\\"d\"\nwww TXT \\065bc\n",
+			records: []string{`@ 3600 TXT "a\"b\\c\"d"`, `www 3600 TXT "Abc"`},
+		},
+		{
+			name:    "invalid escape",
+			content: "@ TXT \"\\256\"\n",
+			err:     `line 1: invalid escape \256`,
+		},
+		{
+			name:    "multi-string TXT",
+			content: "@ TXT ( \"v=DKIM1; k=rsa; \"\n  \"p=MIGf\" \"MA0G\" )\n",
+			records: []string{`@ 3600 TXT "v=DKIM1; k=rsa; p=MIGfMA0G"`},
+		},
+		{
+			name:    "MX",
+			content: "@ MX 10 mail.example.org.\n",
+			records: []string{"@ 3600 MX 10 mail.example.org"},
+		},
+		{
+			name:    "MX field count",
+			content: "@ MX 10\n",
+			err:     "line 1: MX record needs 2 fields, got 1",
+		},
+		{
+			name:    "MX priority",
+			content: "@ MX 65536 mail\n",
+			err:     "line 1: invalid number 65536",
+		},
+		{
+			name:    "SRV",
+			content: "_sip._tcp SRV 10 60 5060 sip\n",
+			records: []string{"_sip._tcp 3600 SRV 10 60 5060 sip.example.com"},
+		},
+		{
+			name:    "SRV field count",
+			content: "_sip._tcp SRV 10 60 sip\n",
+			err:     "line 1: SRV record needs 4 fields, got 3",
+		},
+		{
+			name:        "CAA",
+			content:     "@ CAA 0 issue \"letsencrypt.org\"\n@ CAA 128 IODEF \"mailto:security@example.com\"\n@ CAA 0 tbs \"unknown\"\n",
+			records:     []string{`@ 3600 CAA 0 issue "letsencrypt.org"`, `@ 3600 CAA 128 iodef "mailto:security@example.com"`},
+			unsupported: []string{`line 3: @ CAA 0 tbs "unknown": CAA tag tbs is not supported`},
+		},
+		{
+			name:    "CAA field count",
+			content: "@ CAA 0 issue\n",
+			err:     "line 1: CAA record needs 3 fields, got 2",
+		},
+		{
+			name:    "A field count",
+			content: "www A\n",
+			err:     "line 1: A record needs 1 fields, got 0",
+		},
+		{
+			name:    "AAAA address in A record",
+			content: "www A 2001:db8::1\n",
+			err:     "line 1: invalid A record address 2001:db8::1",
+		},
+		{
+			name: "unsupported",
+			content: "@ SOA ns1 hostmaster ( 1 7200 3600\n  1209600 300 )\n" +
+				"@ NS kiki.bunny.net.\n" +
+				"sub NS ns1.example.org.\n" +
+				"@ CH TXT \"chaos\"\n" +
+				"$INCLUDE other.zone\n" +
+				"@ HINFO cpu os\n" +
+				"www A 192.0.2.1\n" +
+				"www IN A 192.0.2.1\n" +
+				"www 300 A 192.0.2.1\n",
+			records: []string{"sub 3600 NS ns1.example.org", "www 3600 A 192.0.2.1"},
+			unsupported: []string{
+				"line 1: @ SOA ns1 hostmaster 1 7200 3600 1209600 300: the SOA record of the zone is managed by bunny.net",
+				"line 3: @ NS kiki.bunny.net.: the NS records of the zone are managed by bunny.net",
+				`line 5: @ CH TXT "chaos": class CH is not supported`,
+				"line 6: $INCLUDE other.zone: directive $INCLUDE is not supported",
+				"line 7: @ HINFO cpu os: record type HINFO is not supported by Bunny DNS",
+				"line 9: www IN A 192.0.2.1: duplicate record",
+				"line 10: www 300 A 192.0.2.1: duplicate record",
+			},
+		},
+		{
+			name:    "record without owner",
+			content: "  A 192.0.2.1\n",
+			err:     "line 1: record without owner name",
+		},
+		{
+			name:    "unclosed parenthesis",
+			content: "@ 3600 A 192.0.2.1\n@ MX ( 10\n  mail\n",
+			err:     "line 2: unbalanced parenthesis",
+		},
+		{
+			name:    "unopened parenthesis",
+			content: "@ 3600 A 192.0.2.1\n@ MX 10 mail )\n",
+			err:     "line 2: unbalanced parenthesis",
+		},
+		{
+			name:    "unterminated quote",
+			content: "@ A 192.0.2.1\n@ TXT \"v=spf1\n-all\n",
+			err:     "line 2: unterminated quoted string",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			zone, err := Parse(test.content, "example.com.", 3600)
+			if test.err != "" {
+				if err == nil || err.Error() != test.err {
+					t.Fatalf("expected error %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			records := []string{}
+			for _, item := range zone.Records {
+				records = append(records, item.String())
+			}
+			if !reflect.DeepEqual(records, test.records) {
+				t.Errorf("unexpected records:\n%s", strings.Join(records, "\n"))
+			}
+
+			unsupported := []string{}
+			for _, item := range zone.Unsupported {
+				unsupported = append(unsupported, item.String())
+			}
+			if test.unsupported == nil {
+				test.unsupported = []string{}
+			}
+			if !reflect.DeepEqual(unsupported, test.unsupported) {
+				t.Errorf("unexpected unsupported:\n%s", strings.Join(unsupported, "\n"))
+			}
+		})
+	}
+}
+
+func TestRecordKey(t *testing.T) {
+	zone, err := Parse("@ 300 MX 10 mail\n_sip._tcp SRV 10 60 5060 sip\n@ CAA 0 issue \"letsencrypt.org\"\nwww TXT \"v=spf1 -all\"\n", "example.com", 3600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := []string{}
+	for _, item := range zone.Records {
+		keys = append(keys, item.Key())
+	}
+	expected := []string{
+		"@ MX mail.example.com",
+		"_sip._tcp SRV sip.example.com",
+		`@ CAA issue "letsencrypt.org"`,
+		`www TXT "v=spf1 -all"`,
+	}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("unexpected keys:\n%s", strings.Join(keys, "\n"))
+	}
+}
+
+func TestParseTtl(t *testing.T) {
+	tests := []struct {
+		value string
+		ttl   int64
+		err   bool
+	}{
+		{value: "0", ttl: 0},
+		{value: "3600", ttl: 3600},
+		{value: "30s", ttl: 30},
+		{value: "1h30m", ttl: 5400},
+		{value: "1D", ttl: 86400},
+		{value: "1w2d", ttl: 777600},
+		{value: "", err: true},
+		{value: "h", err: true},
+		{value: "1h-", err: true},
+		{value: "2147483648", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			ttl, err := parseTtl(test.value)
+			if test.err {
+				if err == nil {
+					t.Fatalf("expected error, got %d", ttl)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ttl != test.ttl {
+				t.Errorf("expected %d, got %d", test.ttl, ttl)
+			}
+		})
+	}
+}